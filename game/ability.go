@@ -0,0 +1,114 @@
+package keyforge
+
+import "fmt"
+
+// Trigger - Identifies when a card ability resolves.
+type Trigger int
+
+// Ability triggers. TriggerDestroyed resolves while the destroyed card is
+// still in play; TriggerLeavesPlay resolves once it has left. TriggerReap
+// and TriggerFight resolve after a creature reaps or fights, while
+// TriggerBeforeFight resolves before any fight damage is dealt.
+const (
+	TriggerPlay Trigger = iota
+	TriggerAction
+	TriggerOmni
+	TriggerDestroyed
+	TriggerLeavesPlay
+	TriggerReap
+	TriggerFight
+	TriggerBeforeFight
+)
+
+// Effect - A single effect of a card ability. Effects that need a target
+// declare a constraint; the engine enumerates valid targets and the player
+// resolving the effect chooses one before Resolve is called. A targeted
+// effect with no valid target does nothing, while the remaining effects of
+// the ability still resolve. Optional effects ask the player first.
+type Effect struct {
+	Prompt   string
+	Target   *TargetConstraint
+	Optional bool
+	Resolve  func(g *Game, p *Player, source Card, target Card)
+}
+
+// CardAbility - A group of effects resolved together when a trigger fires.
+// Abilities limited to once per turn do nothing when triggered again by the
+// same card during a turn.
+type CardAbility struct {
+	Trigger     Trigger
+	Effects     []Effect
+	OncePerTurn bool
+}
+
+// abilities - Registered card abilities keyed by card title.
+var abilities = map[string][]CardAbility{}
+
+// RegisterAbility - Registers an ability for every card with the given
+// title. Abilities registered for the same trigger resolve in registration
+// order.
+func RegisterAbility(title string, trigger Trigger, effects ...Effect) {
+	ability := CardAbility{Trigger: trigger, Effects: effects}
+	abilities[title] = append(abilities[title], ability)
+}
+
+// RegisterOncePerTurnAbility - Registers an ability for every card with the
+// given title which each card may only resolve once per turn.
+func RegisterOncePerTurnAbility(title string, trigger Trigger, effects ...Effect) {
+	ability := CardAbility{Trigger: trigger, Effects: effects, OncePerTurn: true}
+	abilities[title] = append(abilities[title], ability)
+}
+
+// Abilities - Returns the abilities registered for a card with the given
+// trigger.
+func Abilities(card Card, trigger Trigger) []CardAbility {
+	found := []CardAbility{}
+
+	for _, ability := range abilities[card.CardTitle] {
+		if ability.Trigger == trigger {
+			found = append(found, ability)
+		}
+	}
+
+	return found
+}
+
+// ResolveAbilities - Resolves each of a card's abilities with the given
+// trigger on behalf of player p, skipping once per turn abilities the card
+// has already resolved this turn.
+func (g *Game) ResolveAbilities(p *Player, card Card, trigger Trigger) {
+	for i, ability := range Abilities(card, trigger) {
+		if ability.OncePerTurn && !g.UseOncePerTurn(card, fmt.Sprintf("ability %d:%d", trigger, i)) {
+			fmt.Println(card.CardTitle, "has already used that ability this turn.")
+			continue
+		}
+
+		for _, effect := range ability.Effects {
+			g.ResolveEffect(p, card, effect)
+		}
+	}
+}
+
+// ResolveEffect - Resolves a single effect on behalf of player p. Returns
+// false if the effect did nothing because the player declined an optional
+// effect or there was no valid target.
+func (g *Game) ResolveEffect(p *Player, source Card, effect Effect) bool {
+	if effect.Optional && !p.decisions().ChooseOptional(p, effect.Prompt) {
+		return false
+	}
+
+	target := Card{}
+
+	if effect.Target != nil {
+		var ok bool
+		target, ok = g.ChooseTarget(p, effect.Prompt, *effect.Target)
+
+		if !ok {
+			fmt.Println(source.CardTitle, "has no valid target.")
+			return false
+		}
+	}
+
+	effect.Resolve(g, p, source, target)
+	return true
+}
@@ -0,0 +1,330 @@
+package keyforge
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Step - Identifies the step of the active player's turn the game is in.
+type Step int
+
+// Turn steps. Forging a key, readying cards and drawing cards happen
+// automatically at the start and end of a turn, so decisions are only made
+// while choosing a house and during the main step where cards are played,
+// discarded and used.
+const (
+	StepNone Step = iota
+	StepChooseHouse
+	StepMain
+)
+
+// ActionType - Identifies the kind of action a player can take.
+type ActionType int
+
+// Action types available to the active player.
+const (
+	ActionChooseHouse ActionType = iota
+	ActionTakeArchives
+	ActionPlay
+	ActionDiscard
+	ActionReap
+	ActionFight
+	ActionUseArtifact
+	ActionUseAbility
+	ActionRemoveStun
+	ActionEndTurn
+)
+
+// Action - This type describes a single move a player can make. Card is the
+// card being played, discarded or used, Target is the creature being fought
+// and House is the house being chosen; unused fields are left empty.
+type Action struct {
+	Type   ActionType
+	Player *Player
+	Card   Card
+	Target Card
+	House  string
+}
+
+// String - Returns a human readable description of the action.
+func (a Action) String() string {
+	switch a.Type {
+	case ActionChooseHouse:
+		return fmt.Sprintf("Choose house %s", a.House)
+	case ActionTakeArchives:
+		return "Take archives"
+	case ActionPlay:
+		return fmt.Sprintf("Play %s", a.Card.CardTitle)
+	case ActionDiscard:
+		return fmt.Sprintf("Discard %s", a.Card.CardTitle)
+	case ActionReap:
+		return fmt.Sprintf("Reap with %s", a.Card.CardTitle)
+	case ActionFight:
+		return fmt.Sprintf("Fight %s with %s", a.Target.CardTitle, a.Card.CardTitle)
+	case ActionUseArtifact:
+		return fmt.Sprintf("Use %s", a.Card.CardTitle)
+	case ActionUseAbility:
+		return fmt.Sprintf("Use the action ability of %s", a.Card.CardTitle)
+	case ActionRemoveStun:
+		return fmt.Sprintf("Remove the stun from %s", a.Card.CardTitle)
+	case ActionEndTurn:
+		return "End turn"
+	}
+
+	return "Unknown action"
+}
+
+// Matches - Determine whether two actions describe the same move.
+func (a Action) Matches(other Action) bool {
+	return a.Type == other.Type &&
+		a.Player == other.Player &&
+		a.House == other.House &&
+		a.Card.IsSameCard(other.Card) &&
+		a.Target.IsSameCard(other.Target)
+}
+
+// FilterActions - Return all actions of a given type.
+func FilterActions(actions []Action, actionType ActionType) []Action {
+	filtered := []Action{}
+
+	for _, action := range actions {
+		if action.Type == actionType {
+			filtered = append(filtered, action)
+		}
+	}
+
+	return filtered
+}
+
+// hasAbility - Determine whether a card's text contains an ability with the
+// given prefix, such as "Action:" or "Omni:".
+func hasAbility(card Card, prefix string) bool {
+	return strings.Contains(card.CardText, prefix)
+}
+
+// LegalActions - Returns every action available to the active player at the
+// current step of the turn. The list is empty when no turn is in progress.
+func (g *Game) LegalActions() []Action {
+	p := g.ActivePlayer
+	actions := []Action{}
+
+	if p == nil {
+		return actions
+	}
+
+	switch g.Step {
+	case StepChooseHouse:
+		if len(p.ArchivePile) > 0 {
+			actions = append(actions, Action{Type: ActionTakeArchives, Player: p})
+		}
+
+		for _, house := range GetHouses(p.PlayerDeck.Cards) {
+			actions = append(actions, Action{Type: ActionChooseHouse, Player: p, House: house})
+		}
+	case StepMain:
+		actions = append(actions, g.handActions(p)...)
+		actions = append(actions, g.creatureActions(p)...)
+		actions = append(actions, g.artifactActions(p)...)
+		actions = append(actions, Action{Type: ActionEndTurn, Player: p})
+	}
+
+	return actions
+}
+
+// handActions - Returns the play and discard actions for cards in hand.
+func (g *Game) handActions(p *Player) []Action {
+	actions := []Action{}
+
+	if !g.CanPlayOrDiscard(p) {
+		return actions
+	}
+
+	for _, card := range p.HandPile {
+		if !g.IsActiveHouse(card) {
+			continue
+		}
+
+		if g.CanPlayOrUse(card) {
+			actions = append(actions, Action{Type: ActionPlay, Player: p, Card: card})
+		}
+
+		actions = append(actions, Action{Type: ActionDiscard, Player: p, Card: card})
+	}
+
+	return actions
+}
+
+// creatureActions - Returns the reap, fight and action ability actions for
+// the player's creatures. A stunned creature can only be used to remove its
+// stun, and a creature outside the active house can only use its Omni
+// ability.
+func (g *Game) creatureActions(p *Player) []Action {
+	actions := []Action{}
+	opponent := g.Opponent(p)
+
+	for _, creature := range p.Creatures {
+		if !g.canUse(creature) {
+			continue
+		}
+
+		if creature.IsStunned {
+			actions = append(actions, Action{Type: ActionRemoveStun, Player: p, Card: creature})
+			continue
+		}
+
+		if !g.IsActiveHouse(creature) {
+			actions = append(actions, Action{Type: ActionUseAbility, Player: p, Card: creature})
+			continue
+		}
+
+		actions = append(actions, Action{Type: ActionReap, Player: p, Card: creature})
+
+		if opponent != nil {
+			for _, target := range opponent.Creatures {
+				actions = append(actions, Action{Type: ActionFight, Player: p, Card: creature, Target: target})
+			}
+		}
+
+		if hasAbility(creature, "Action:") || hasAbility(creature, "Omni:") {
+			actions = append(actions, Action{Type: ActionUseAbility, Player: p, Card: creature})
+		}
+	}
+
+	return actions
+}
+
+// artifactActions - Returns the use actions for the player's artifacts.
+func (g *Game) artifactActions(p *Player) []Action {
+	actions := []Action{}
+
+	for _, artifact := range p.Artifacts {
+		if !g.canUse(artifact) {
+			continue
+		}
+
+		if hasAbility(artifact, "Action:") || hasAbility(artifact, "Omni:") {
+			actions = append(actions, Action{Type: ActionUseArtifact, Player: p, Card: artifact})
+		}
+	}
+
+	return actions
+}
+
+// IsActiveHouse - Determine whether a card belongs to the active house.
+func (g *Game) IsActiveHouse(card Card) bool {
+	return strings.EqualFold(card.House, g.ActiveHouse)
+}
+
+// canUse - Determine whether a card in play may be used this step. Only
+// ready cards of the active house may be used, except that cards with an
+// Omni ability may be used to trigger it regardless of house.
+func (g *Game) canUse(card Card) bool {
+	return !card.IsExhausted &&
+		(g.IsActiveHouse(card) || hasAbility(card, "Omni:")) &&
+		g.CanPlayOrUse(card)
+}
+
+// UsesOmni - Determine whether using a card in play would trigger its
+// "Omni:" ability rather than its "Action:" ability.
+func (g *Game) UsesOmni(card Card) bool {
+	if g.IsActiveHouse(card) && hasAbility(card, "Action:") {
+		return false
+	}
+
+	return hasAbility(card, "Omni:")
+}
+
+// IsLegal - Determine whether an action is currently available.
+func (g *Game) IsLegal(action Action) bool {
+	for _, legal := range g.LegalActions() {
+		if legal.Matches(action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Apply - Carry out an action for the active player. Actions which are not
+// currently legal are rejected with an error and leave the game untouched.
+func (g *Game) Apply(action Action) error {
+	if !g.IsLegal(action) {
+		errorMessage := fmt.Sprintf("illegal action: %s", action)
+		return errors.New(errorMessage)
+	}
+
+	p := action.Player
+
+	switch action.Type {
+	case ActionChooseHouse:
+		g.ActiveHouse = action.House
+		g.Step = StepMain
+		fmt.Println(p.Name, "chose house", action.House)
+		g.Emit(Event{Type: EventHouseChosen, Player: p})
+	case ActionTakeArchives:
+		fmt.Println(p.Name, "takes", len(p.ArchivePile), "cards from archives.")
+		p.TakeArchives()
+	case ActionPlay:
+		p.PlayCard(action.Card)
+	case ActionDiscard:
+		p.Discard(action.Card)
+	case ActionReap:
+		return g.Reap(p, action.Card)
+	case ActionFight:
+		return g.Fight(p, action.Card, action.Target)
+	case ActionUseArtifact, ActionUseAbility:
+		return g.UseCard(p, action.Card)
+	case ActionRemoveStun:
+		return g.RemoveStun(p, action.Card)
+	case ActionEndTurn:
+		g.FinishTurn()
+	}
+
+	return nil
+}
+
+// BeginTurn - Starts the given player's turn. A key is forged if the player
+// can afford one, and the game ends if that was their third key. Otherwise
+// the player moves on to choosing a house.
+func (g *Game) BeginTurn(p *Player) {
+	g.ActivePlayer = p
+	g.ActiveHouse = ""
+	g.Step = StepNone
+
+	if p.Amber > 6 {
+		p.ForgeKey()
+	}
+
+	if p.Keys > 2 {
+		fmt.Println("")
+		fmt.Println(p.Name, "WINS THE GAME!")
+		g.Running = false
+		return
+	}
+
+	g.Step = StepChooseHouse
+}
+
+// FinishTurn - Ends the active player's turn. The player readies their
+// cards and draws back up, the per-turn bookkeeping is cleared and the
+// opponent's turn begins.
+func (g *Game) FinishTurn() {
+	p := g.ActivePlayer
+	p.ReadyCards()
+	p.DrawHand()
+
+	if g.Simulation {
+		p.PrettyPrintHand()
+	}
+
+	g.EndTurn()
+
+	next := g.Opponent(p)
+
+	if next == nil {
+		next = p
+	}
+
+	g.BeginTurn(next)
+}
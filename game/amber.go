@@ -0,0 +1,127 @@
+package keyforge
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GainAmber - Add aember to the player's pool.
+func (p *Player) GainAmber(amount int) int {
+	if amount <= 0 {
+		return 0
+	}
+
+	p.Amber += amount
+	p.emit(Event{Type: EventAmberGained, Player: p, Amount: amount})
+
+	return amount
+}
+
+// LoseAmber - Remove aember from the player's pool. A player's pool never
+// drops below zero, so the amount actually lost is returned.
+func (p *Player) LoseAmber(amount int) int {
+	if amount > p.Amber {
+		amount = p.Amber
+	}
+
+	if amount <= 0 {
+		return 0
+	}
+
+	p.Amber -= amount
+	p.emit(Event{Type: EventAmberLost, Player: p, Amount: amount})
+
+	return amount
+}
+
+// StealAmber - Move aember from another player's pool into this player's
+// pool. Returns the amount actually stolen, which is limited by the amount
+// of aember the other player has.
+func (p *Player) StealAmber(from *Player, amount int) int {
+	if amount > from.Amber {
+		amount = from.Amber
+	}
+
+	if amount <= 0 {
+		return 0
+	}
+
+	from.Amber -= amount
+	p.Amber += amount
+	p.emit(Event{Type: EventAmberStolen, Player: p, Target: from, Amount: amount})
+
+	return amount
+}
+
+// CaptureAmber - Take aember from the opponent's pool and place it on a
+// creature controlled by this player. Returns the amount actually captured.
+func (p *Player) CaptureAmber(creature Card, amount int) (int, error) {
+	if p.Game == nil {
+		return 0, errors.New("cannot capture aember outside of a game")
+	}
+
+	target, e := p.FindCreature(creature)
+
+	if e != nil {
+		return 0, e
+	}
+
+	opponent := p.Game.Opponent(p)
+
+	if opponent == nil {
+		return 0, errors.New("no opponent to capture aember from")
+	}
+
+	if amount > opponent.Amber {
+		amount = opponent.Amber
+	}
+
+	if amount <= 0 {
+		return 0, nil
+	}
+
+	opponent.Amber -= amount
+	target.CapturedAmber += amount
+	p.emit(Event{Type: EventAmberCaptured, Player: p, Target: opponent, Card: *target, Amount: amount})
+
+	return amount, nil
+}
+
+// FindCreature - Returns a pointer to a creature on this player's battleline
+// so that its in-play state can be changed directly. The pointer is only
+// valid until the battleline is next modified.
+func (p *Player) FindCreature(creature Card) (*Card, error) {
+	for i := range p.Creatures {
+		if p.Creatures[i].IsSameCard(creature) {
+			return &p.Creatures[i], nil
+		}
+	}
+
+	errorMessage := fmt.Sprintf("%s is not on %s's battleline", creature.CardTitle, p.Name)
+	return nil, errors.New(errorMessage)
+}
+
+// releaseCapturedAmber - Called when a creature leaves play. Any aember on
+// the creature goes to the opponent of the player who controlled it.
+func releaseCapturedAmber(controller *Player, card *Card) {
+	amount := card.CapturedAmber
+
+	if amount == 0 {
+		return
+	}
+
+	card.CapturedAmber = 0
+
+	if controller == nil || controller.Game == nil {
+		return
+	}
+
+	opponent := controller.Game.Opponent(controller)
+
+	if opponent == nil {
+		return
+	}
+
+	opponent.Amber += amount
+	opponent.emit(Event{Type: EventAmberReleased, Player: opponent, Target: controller, Card: *card, Amount: amount})
+}
@@ -11,9 +11,11 @@ type Bot struct {
 	Player
 }
 
-// NewBot - Create a new bot object and return a pointer.
+// NewBot - Create a new bot object and return a pointer. The bot makes its
+// own decisions during play.
 func NewBot() *Bot {
 	bot := new(Bot)
+	bot.Decisions = bot
 	return bot
 }
 
@@ -51,13 +53,18 @@ func (b *Bot) DetermineMulligan() bool {
 // DetermineActiveHouse - This function is intended to be used to allow the
 // bot to declare an active house at the beginning of its turn.
 func (b *Bot) DetermineActiveHouse() string {
-	houses := GetHouses(b.HandPile)
+	return bestHouse(b.HandPile)
+}
+
+// bestHouse - Returns the house with the most cards in a given card pile.
+func bestHouse(pile []Card) string {
+	houses := GetHouses(pile)
 	cards := map[string][]Card{}
 	maxCount := 0
 	houseChoice := ""
 
 	for _, house := range houses {
-		foundCards, _ := FindCardsByHouse(b.HandPile, house)
+		foundCards, _ := FindCardsByHouse(pile, house)
 		cards[house] = foundCards
 	}
 
@@ -71,6 +78,24 @@ func (b *Bot) DetermineActiveHouse() string {
 	return houseChoice
 }
 
+// DetermineTakeArchives - This function is intended to decide whether the
+// bot picks up its archives at the start of the house choice step. Archives
+// are taken when they hold cards of the house the bot would choose with them
+// in hand.
+func (b *Bot) DetermineTakeArchives() bool {
+	if len(b.ArchivePile) == 0 {
+		return false
+	}
+
+	cards := append([]Card{}, b.HandPile...)
+	cards = append(cards, b.ArchivePile...)
+	house := bestHouse(cards)
+
+	_, e := FindCardsByHouse(b.ArchivePile, house)
+
+	return e == nil
+}
+
 // PlayCards - This function plays cards from a given house.
 func (b *Bot) PlayCards(house string) {
 	cards, e := FindCardsByHouse(b.HandPile, house)
@@ -81,6 +106,118 @@ func (b *Bot) PlayCards(house string) {
 	}
 
 	for _, card := range cards {
+		if b.Game != nil && !b.Game.CanPlayOrDiscard(&b.Player) {
+			break
+		}
+
 		b.PlayCard(card)
 	}
 }
+
+// ChooseMulligan - DecisionProvider implementation; see DetermineMulligan.
+func (b *Bot) ChooseMulligan(p *Player) bool {
+	return b.DetermineMulligan()
+}
+
+// ChooseTakeArchives - DecisionProvider implementation; see
+// DetermineTakeArchives.
+func (b *Bot) ChooseTakeArchives(p *Player) bool {
+	return b.DetermineTakeArchives()
+}
+
+// ChooseHouse - DecisionProvider implementation; see DetermineActiveHouse.
+// Falls back to the first house offered if the bot has no preference.
+func (b *Bot) ChooseHouse(p *Player, houses []string) string {
+	house := b.DetermineActiveHouse()
+
+	if HouseExists(houses, house) || len(houses) == 0 {
+		return house
+	}
+
+	return houses[0]
+}
+
+// ChooseAction - DecisionProvider implementation. The bot plays cards
+// first, then fights when it can destroy a creature and survive, then reaps
+// and finally uses its remaining cards before ending its turn. Omni
+// abilities, which are often drastic, are only used while the bot has fewer
+// creatures than its opponent.
+func (b *Bot) ChooseAction(p *Player, actions []Action) Action {
+	if plays := FilterActions(actions, ActionPlay); len(plays) > 0 {
+		return plays[0]
+	}
+
+	for _, fight := range FilterActions(actions, ActionFight) {
+		if b.destroys(p.Game, fight.Card, fight.Target) && !b.destroys(p.Game, fight.Target, fight.Card) {
+			return fight
+		}
+	}
+
+	if reaps := FilterActions(actions, ActionReap); len(reaps) > 0 {
+		return reaps[0]
+	}
+
+	for _, use := range append(FilterActions(actions, ActionUseArtifact), FilterActions(actions, ActionUseAbility)...) {
+		if !p.Game.UsesOmni(use.Card) || b.behindOnBoard(p) {
+			return use
+		}
+	}
+
+	if stuns := FilterActions(actions, ActionRemoveStun); len(stuns) > 0 {
+		return stuns[0]
+	}
+
+	return Action{Type: ActionEndTurn, Player: p}
+}
+
+// destroys - Determine whether fight damage from one creature would destroy
+// another, taking current power, unused armor and existing damage into
+// account.
+func (b *Bot) destroys(g *Game, source Card, target Card) bool {
+	if g.PreventsDamage(target) {
+		return false
+	}
+
+	damage := g.Power(source) - (g.Armor(target) - target.ArmorUsed)
+	return damage > 0 && target.Damage+damage >= g.Power(target)
+}
+
+// behindOnBoard - Determine whether the player has fewer creatures in play
+// than their opponent.
+func (b *Bot) behindOnBoard(p *Player) bool {
+	opponent := p.Game.Opponent(p)
+	return opponent != nil && len(opponent.Creatures) > len(p.Creatures)
+}
+
+// ChooseTrigger - DecisionProvider implementation. The bot resolves
+// triggered abilities in the order they fired.
+func (b *Bot) ChooseTrigger(p *Player, cards []Card) (Card, bool) {
+	if len(cards) == 0 {
+		return Card{}, false
+	}
+
+	return cards[0], true
+}
+
+// ChooseTarget - DecisionProvider implementation. The bot picks the first
+// valid target. Targets for harmful effects list enemy cards first, so the
+// bot aims them at its opponent whenever it can.
+func (b *Bot) ChooseTarget(p *Player, prompt string, targets []Card) (Card, bool) {
+	if len(targets) == 0 {
+		return Card{}, false
+	}
+
+	return targets[0], true
+}
+
+// ChooseFlank - DecisionProvider implementation. The bot always deploys on
+// the right flank.
+func (b *Bot) ChooseFlank(p *Player, card Card) Flank {
+	return RightFlank
+}
+
+// ChooseOptional - DecisionProvider implementation. The bot resolves every
+// optional effect.
+func (b *Bot) ChooseOptional(p *Player, prompt string) bool {
+	return true
+}
@@ -8,30 +8,61 @@ import (
 	"math/rand"
 	"os"
 	"strings"
+	"sync/atomic"
 )
 
+// instanceCounter - Source of unique instance IDs handed out to in-game cards.
+var instanceCounter int64
+
 // Card - this type represents a card, both cards used within the game as well
 // as the Vault API.
 type Card struct {
-	ID          string `json:"id"`
-	CardTitle   string `json:"card_title"`
-	House       string `json:"house"`
-	CardType    string `json:"card_type"`
-	FrontImage  string `json:"front_image"`
-	CardText    string `json:"card_text"`
-	Traits      string `json:"traits"`
-	Amber       int    `json:"amber"`
-	Power       int    `json:"power"`
-	Armor       int    `json:"armor"`
-	Rarity      string `json:"rarity"`
-	FlavorText  string `json:"flavor_text"`
-	CardNumber  int    `json:"card_number"`
-	Expansion   int    `json:"expansion"`
-	IsMaverick  bool   `json:"is_maverick"`
-	IsExhausted bool   `json:"-"`
-	IsStunned   bool   `json:"-"`
-	PowerBonus  int    `json:"-"`
-	ArmorBonus  int    `json:"-"`
+	ID            string  `json:"id"`
+	CardTitle     string  `json:"card_title"`
+	House         string  `json:"house"`
+	CardType      string  `json:"card_type"`
+	FrontImage    string  `json:"front_image"`
+	CardText      string  `json:"card_text"`
+	Traits        string  `json:"traits"`
+	Amber         int     `json:"amber"`
+	Power         int     `json:"power"`
+	Armor         int     `json:"armor"`
+	Rarity        string  `json:"rarity"`
+	FlavorText    string  `json:"flavor_text"`
+	CardNumber    int     `json:"card_number"`
+	Expansion     int     `json:"expansion"`
+	IsMaverick    bool    `json:"is_maverick"`
+	IsExhausted   bool    `json:"-"`
+	IsStunned     bool    `json:"-"`
+	PowerBonus    int     `json:"-"`
+	ArmorBonus    int     `json:"-"`
+	InstanceID    int     `json:"-"`
+	Owner         *Player `json:"-"`
+	Controller    *Player `json:"-"`
+	CapturedAmber int     `json:"-"`
+	Damage        int     `json:"-"`
+	ArmorUsed     int     `json:"-"`
+}
+
+// NewCardInstance - Returns a copy of a card suitable for use within a game.
+// Each instance receives a unique instance ID so that duplicate cards in a
+// deck can be told apart, and is marked as owned and controlled by the given
+// player.
+func NewCardInstance(card Card, owner *Player) Card {
+	card.InstanceID = int(atomic.AddInt64(&instanceCounter, 1))
+	card.Owner = owner
+	card.Controller = owner
+	return card
+}
+
+// IsSameCard - Determine whether two cards refer to the same card. In-game
+// instances are compared by instance ID, otherwise card IDs are compared.
+func (c *Card) IsSameCard(other Card) bool {
+	if c.InstanceID != 0 && other.InstanceID != 0 {
+		return c.InstanceID == other.InstanceID
+	}
+
+	return c.ID == other.ID
 }
 
 // Stun - Mark a creature card as stunned.
@@ -43,18 +74,10 @@ func (c *Card) Stun() {
 	c.IsStunned = true
 }
 
-// Ready a given card. If a creature card is stunned then a second call
-// to this function is required in order to remove exhaustion.
+// Ready a given card. Readying does not remove a stun; a stunned creature
+// stays stunned until it is next used.
 func (c *Card) Ready() {
-	// If stunned, remove stun, but do not ready.
-	if c.IsStunned {
-		c.IsStunned = false
-		return
-	}
-
-	if c.IsExhausted {
-		c.IsExhausted = false
-	}
+	c.IsExhausted = false
 }
 
 // PrettyPrint - Used to debug card data without making your eyes bleed.
@@ -127,6 +150,29 @@ func FindCardByID(cards []Card, cardID string) (Card, error) {
 	return Card{}, errors.New(errorMessage)
 }
 
+// FindCardByInstanceID - Find a card in a pile given an in-game instance ID.
+func FindCardByInstanceID(cards []Card, instanceID int) (Card, error) {
+	for _, card := range cards {
+		if card.InstanceID == instanceID {
+			return card, nil
+		}
+	}
+	errorMessage := fmt.Sprintf("no card found with instance ID %d", instanceID)
+	return Card{}, errors.New(errorMessage)
+}
+
+// findSameCard - Find the stored copy of a card within a pile, matching
+// in-game instances where possible.
+func findSameCard(cards []Card, card Card) (Card, error) {
+	for _, pileCard := range cards {
+		if pileCard.IsSameCard(card) {
+			return pileCard, nil
+		}
+	}
+	errorMessage := fmt.Sprintf("card %s not found in pile", card.CardTitle)
+	return Card{}, errors.New(errorMessage)
+}
+
 // FindCardsByID - Find a cards in a pile given a card ID.
 func FindCardsByID(cards []Card, cardID string) ([]Card, error) {
 	totalCards := []Card{}
@@ -296,10 +342,10 @@ func RemoveCard(cards []Card, removeCard Card) []Card {
 	}
 
 	for _, card := range cards {
-		if card.ID != removeCard.ID || found {
+		if !card.IsSameCard(removeCard) || found {
 			returnCards = append(returnCards, card)
 		}
-		if card.ID == removeCard.ID && !found {
+		if card.IsSameCard(removeCard) && !found {
 			found = true
 		}
 	}
@@ -0,0 +1,196 @@
+package keyforge
+
+import "fmt"
+
+// targetOf - Returns a pointer to a constraint so that it can be used as an
+// effect target.
+func targetOf(constraint TargetConstraint) *TargetConstraint {
+	return &constraint
+}
+
+func init() {
+	RegisterAbility("Anger", TriggerPlay, Effect{
+		Prompt: "Choose a friendly creature to ready and fight with:",
+		Target: targetOf(Creature(SideFriendly)),
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			g.ReadyAndFight(p, target)
+		},
+	})
+
+	RegisterAbility("Punch", TriggerPlay, Effect{
+		Prompt: "Choose a creature to deal 3 damage to:",
+		Target: targetOf(TargetConstraint{CardType: "creature", Harmful: true}),
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			g.DealDamage(target, 3)
+		},
+	})
+
+	RegisterAbility("Hand of Dis", TriggerPlay, Effect{
+		Prompt: "Choose a creature that is not on a flank to destroy:",
+		Target: targetOf(TargetConstraint{CardType: "creature", NotOnFlank: true, Harmful: true}),
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			g.Destroy(target)
+		},
+	})
+
+	RegisterAbility("Fear", TriggerPlay, Effect{
+		Prompt: "Choose an enemy creature to return to its owner's hand:",
+		Target: targetOf(Creature(SideEnemy)),
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			MoveToHand(target)
+		},
+	})
+
+	RegisterAbility("Finishing Blow", TriggerPlay, Effect{
+		Prompt: "Choose a damaged creature to destroy:",
+		Target: targetOf(TargetConstraint{CardType: "creature", Damaged: true, Harmful: true}),
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			if g.Destroy(target) == nil {
+				p.StealAmber(g.Opponent(p), 1)
+			}
+		},
+	})
+
+	RegisterAbility("The Harder They Come", TriggerPlay, Effect{
+		Prompt: "Choose a creature with power 5 or higher to purge:",
+		Target: targetOf(TargetConstraint{CardType: "creature", MinimumPower: 5, Harmful: true}),
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			Purge(target)
+		},
+	})
+
+	RegisterAbility("Oubliette", TriggerPlay, Effect{
+		Prompt: "Choose a creature with power 3 or lower to purge:",
+		Target: targetOf(TargetConstraint{CardType: "creature", MaximumPower: 3, Harmful: true}),
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			Purge(target)
+		},
+	})
+
+	RegisterAbility("Terms of Redress", TriggerPlay, Effect{
+		Prompt: "Choose a friendly creature to capture 2 aember:",
+		Target: targetOf(Creature(SideFriendly)),
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			p.CaptureAmber(target, 2)
+		},
+	})
+
+	RegisterAbility("Banner of Battle", TriggerPlay, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			g.AddLastingEffect(LastingEffect{Source: source, Controller: p,
+				Scope: Creature(SideFriendly), Duration: DurationWhileInPlay, PowerModifier: 1})
+		},
+	})
+
+	RegisterAbility("Grey Monk", TriggerPlay, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			g.AddLastingEffect(LastingEffect{Source: source, Controller: p,
+				Scope: Creature(SideFriendly), Duration: DurationWhileInPlay, ArmorModifier: 1})
+		},
+	})
+
+	RegisterAbility("Shield of Justice", TriggerPlay, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			g.AddLastingEffect(LastingEffect{Source: source, Controller: p,
+				Scope: Creature(SideFriendly), Duration: DurationThisTurn, PreventDamage: true})
+		},
+	})
+
+	RegisterAbility("Key to Dis", TriggerOmni, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			g.Destroy(source)
+			g.DestroyAll(g.CreaturesInPlay())
+		},
+	})
+
+	RegisterAbility("Screechbomb", TriggerOmni, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			g.Destroy(source)
+
+			if opponent := g.Opponent(p); opponent != nil {
+				opponent.LoseAmber(2)
+			}
+		},
+	})
+
+	RegisterAbility("Headhunter", TriggerFight, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			p.GainAmber(1)
+		},
+	})
+
+	RegisterAbility("Tocsin", TriggerReap, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			opponent := g.Opponent(p)
+
+			if opponent == nil || len(opponent.HandPile) == 0 {
+				return
+			}
+
+			_, card := ChooseRandomCard(opponent.HandPile)
+			MoveToDiscard(card)
+		},
+	})
+
+	RegisterAbility("Doc Bookton", TriggerReap, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			p.DrawCard()
+		},
+	})
+
+	RegisterAbility("Dextre", TriggerPlay, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			p.CaptureAmber(source, 1)
+		},
+	})
+
+	RegisterAbility("Dextre", TriggerDestroyed, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			MoveToDeck(source)
+		},
+	})
+
+	RegisterAbility("Research Smoko", TriggerDestroyed, Effect{
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			if len(p.DrawPile) > 0 {
+				MoveToArchives(p.DrawPile[len(p.DrawPile)-1], p)
+			}
+		},
+	})
+
+	RegisterAbility("Sneklifter", TriggerPlay, Effect{
+		Prompt: "Choose an enemy artifact to take control of:",
+		Target: targetOf(Artifact(SideEnemy)),
+		Resolve: func(g *Game, p *Player, source Card, target Card) {
+			TakeControl(target, p, RightFlank)
+		},
+	})
+}
+
+// ReadyAndFight - Readies a friendly creature and fights with it against an
+// enemy creature chosen by player p. The creature is still readied if there
+// is nothing for it to fight.
+func (g *Game) ReadyAndFight(p *Player, creature Card) {
+	stored, e := p.FindCreature(creature)
+
+	if e != nil {
+		fmt.Println(e)
+		return
+	}
+
+	stored.IsExhausted = false
+	creature = *stored
+
+	prompt := fmt.Sprintf("Choose an enemy creature for %s to fight:", creature.CardTitle)
+	target, ok := g.ChooseTarget(p, prompt, Creature(SideEnemy))
+
+	if !ok {
+		return
+	}
+
+	e = g.Fight(p, creature, target)
+
+	if e != nil {
+		fmt.Println(e)
+	}
+}
@@ -0,0 +1,425 @@
+package keyforge
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ReapAmber - The amount of aember a creature gains its controller when it
+// reaps.
+const ReapAmber = 1
+
+// exhaustInPlay - Exhausts a card in play and records it as used. Returns a
+// pointer to the stored card. Using a stunned creature only removes its
+// stun, in which case false is returned and the reap, fight or action
+// should not go ahead.
+func (g *Game) exhaustInPlay(p *Player, card Card) (*Card, bool, error) {
+	pile := p.Pile(ZoneCreatures)
+
+	if p.FindCardZone(card) == ZoneArtifacts {
+		pile = p.Pile(ZoneArtifacts)
+	}
+
+	for i := range *pile {
+		stored := &(*pile)[i]
+
+		if !stored.IsSameCard(card) {
+			continue
+		}
+
+		if stored.IsExhausted {
+			errorMessage := fmt.Sprintf("%s is exhausted", stored.CardTitle)
+			return nil, false, errors.New(errorMessage)
+		}
+
+		e := g.RecordUse(*stored)
+
+		if e != nil {
+			return nil, false, e
+		}
+
+		if stored.IsStunned {
+			g.removeStun(p, stored)
+			return stored, false, nil
+		}
+
+		stored.IsExhausted = true
+		return stored, true, nil
+	}
+
+	errorMessage := fmt.Sprintf("%s is not in play under %s's control", card.CardTitle, p.Name)
+	return nil, false, errors.New(errorMessage)
+}
+
+// Reap - Exhausts a creature to gain its controller one aember.
+func (g *Game) Reap(p *Player, creature Card) error {
+	reaper, reaped, e := g.exhaustInPlay(p, creature)
+
+	if e != nil || !reaped {
+		return e
+	}
+
+	creature = *reaper
+
+	fmt.Println(p.Name, "reaps with", creature.CardTitle)
+	g.Emit(Event{Type: EventReap, Player: p, Card: creature})
+	p.GainAmber(ReapAmber)
+	g.Trigger(p, creature, TriggerReap)
+
+	return nil
+}
+
+// Fight - Exhausts a creature to fight an enemy creature. Each creature
+// deals damage equal to its power to the other at the same time. "Before
+// Fight:" abilities resolve first, and "Fight:" abilities resolve afterwards
+// if the attacker survived. No damage is dealt the first time an Elusive
+// creature is attacked each turn.
+func (g *Game) Fight(p *Player, attacker Card, defender Card) error {
+	opponent := g.Opponent(p)
+
+	if opponent == nil {
+		return errors.New("no opponent to fight")
+	}
+
+	target, e := opponent.FindCreature(defender)
+
+	if e != nil {
+		return e
+	}
+
+	defender = *target
+
+	fighter, fought, e := g.exhaustInPlay(p, attacker)
+
+	if e != nil || !fought {
+		return e
+	}
+
+	attacker = *fighter
+
+	fmt.Println(p.Name, "fights", defender.CardTitle, "with", attacker.CardTitle)
+	g.Emit(Event{Type: EventFight, Player: p, Target: opponent, Card: attacker})
+	g.Trigger(p, attacker, TriggerBeforeFight)
+
+	if hasAbility(defender, "Elusive") && g.UseOncePerTurn(defender, "Elusive") {
+		fmt.Println(defender.CardTitle, "is elusive, no damage is dealt.")
+
+		if attacker, e = findInPlay(attacker); e == nil {
+			g.Trigger(p, attacker, TriggerFight)
+		}
+
+		return nil
+	}
+
+	// Hazardous and Assault damage is dealt before the fight. A creature
+	// destroyed by it deals no fight damage.
+	g.DealDamage(attacker, KeywordValue(defender, "Hazardous"))
+	g.DealDamage(defender, KeywordValue(attacker, "Assault"))
+
+	attacker, attackerErr := findInPlay(attacker)
+	defender, defenderErr := findInPlay(defender)
+
+	if attackerErr != nil || defenderErr != nil {
+		return nil
+	}
+
+	destroyed := []Card{}
+
+	if g.damage(defender, g.Power(attacker)) {
+		destroyed = append(destroyed, defender)
+	}
+
+	if g.damage(attacker, g.Power(defender)) {
+		destroyed = append(destroyed, attacker)
+	}
+
+	g.DestroyAll(destroyed)
+
+	if attacker, e = findInPlay(attacker); e == nil {
+		g.Trigger(p, attacker, TriggerFight)
+	}
+
+	return nil
+}
+
+// KeywordValue - Returns the value of a numbered keyword such as
+// "Hazardous 3" or "Assault 2" in a card's text, or zero if the card does
+// not have the keyword. Only the digits following the keyword are read, as
+// card text often runs straight on into reminder text ("Assault 2.(Before").
+func KeywordValue(card Card, keyword string) int {
+	match := keywordPattern(keyword).FindStringSubmatch(card.CardText)
+
+	if match == nil {
+		return 0
+	}
+
+	value, e := strconv.Atoi(match[1])
+
+	if e != nil {
+		return 0
+	}
+
+	return value
+}
+
+// keywordPatterns - Compiled keyword patterns, keyed by lower case keyword,
+// so each is only compiled once however many fights are simulated.
+var keywordPatterns = map[string]*regexp.Regexp{}
+
+// keywordMutex - Guards keywordPatterns, as games may run concurrently.
+var keywordMutex sync.Mutex
+
+// keywordPattern - Returns the pattern matching a numbered keyword and
+// capturing its value.
+func keywordPattern(keyword string) *regexp.Regexp {
+	key := strings.ToLower(keyword)
+
+	keywordMutex.Lock()
+	defer keywordMutex.Unlock()
+
+	pattern, ok := keywordPatterns[key]
+
+	if !ok {
+		pattern = regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(key) + `\s+(\d+)`)
+		keywordPatterns[key] = pattern
+	}
+
+	return pattern
+}
+
+// findInPlay - Returns the current state of a creature in play.
+func findInPlay(creature Card) (Card, error) {
+	holder, zone, e := LocateCard(creature)
+
+	if e != nil {
+		return Card{}, e
+	}
+
+	if zone != ZoneCreatures {
+		errorMessage := fmt.Sprintf("%s is not in play", creature.CardTitle)
+		return Card{}, errors.New(errorMessage)
+	}
+
+	target, e := holder.FindCreature(creature)
+
+	if e != nil {
+		return Card{}, e
+	}
+
+	return *target, nil
+}
+
+// DealDamage - Deals damage to a creature in play. Armor the creature has
+// not yet used this turn prevents damage first. A creature with damage
+// equal to or greater than its power is destroyed.
+func (g *Game) DealDamage(creature Card, amount int) {
+	if g.damage(creature, amount) {
+		g.Destroy(creature)
+	}
+}
+
+// damage - Deals damage to a creature in play without destroying it.
+// Returns true if the creature has taken lethal damage.
+func (g *Game) damage(creature Card, amount int) bool {
+	if amount <= 0 || g.PreventsDamage(creature) {
+		return false
+	}
+
+	holder, zone, e := LocateCard(creature)
+
+	if e != nil || zone != ZoneCreatures {
+		return false
+	}
+
+	target, e := holder.FindCreature(creature)
+
+	if e != nil {
+		return false
+	}
+
+	absorbed := g.Armor(*target) - target.ArmorUsed
+
+	if absorbed > amount {
+		absorbed = amount
+	}
+
+	if absorbed > 0 {
+		target.ArmorUsed += absorbed
+		amount -= absorbed
+	}
+
+	if amount == 0 {
+		return false
+	}
+
+	target.Damage += amount
+	g.Emit(Event{Type: EventDamageDealt, Player: holder, Card: *target, Amount: amount})
+
+	return target.Damage >= g.Power(*target)
+}
+
+// refreshArmor - Resets the armor used by every creature in play. Called
+// as the turn ends so each creature's armor applies afresh next turn.
+func (g *Game) refreshArmor() {
+	for _, player := range g.Players {
+		for i := range player.Creatures {
+			player.Creatures[i].ArmorUsed = 0
+		}
+	}
+}
+
+// Destroy - Destroys a card in play, sending it to its owner's discard pile.
+func (g *Game) Destroy(card Card) error {
+	return g.DestroyAll([]Card{card})
+}
+
+// DestroyAll - Destroys cards in play at the same time. Every "Destroyed:"
+// ability resolves while the cards are still in play, then each card still
+// in play is put into its owner's discard pile and "Leaves play:" abilities
+// resolve. Returns an error if any card was not in play; the remaining
+// cards are still destroyed.
+func (g *Game) DestroyAll(cards []Card) error {
+	var e error
+	destroyed := []Card{}
+
+	g.openWindow()
+
+	for _, card := range cards {
+		holder, zone, locateErr := LocateCard(card)
+
+		if locateErr != nil {
+			e = locateErr
+			continue
+		}
+
+		if !zone.InPlay() {
+			errorMessage := fmt.Sprintf("cannot destroy %s, card is not in play", card.CardTitle)
+			e = errors.New(errorMessage)
+			continue
+		}
+
+		card, _ = findSameCard(*holder.Pile(zone), card)
+
+		fmt.Println(card.CardTitle, "is destroyed.")
+		g.Emit(Event{Type: EventCardDestroyed, Player: holder, Card: card})
+		g.queueTrigger(holder, card, TriggerDestroyed)
+		destroyed = append(destroyed, card)
+	}
+
+	g.closeWindow()
+	g.openWindow()
+
+	for _, card := range destroyed {
+		if _, zone, locateErr := LocateCard(card); locateErr == nil && zone.InPlay() {
+			MoveToDiscard(card)
+		}
+	}
+
+	g.closeWindow()
+
+	return e
+}
+
+// UseCard - Exhausts a creature or artifact to use its "Action:" or "Omni:"
+// ability. Action abilities may only be used while the card's house is
+// active; Omni abilities may be used regardless of house.
+func (g *Game) UseCard(p *Player, card Card) error {
+	stored, e := findSameCard(append(append([]Card{}, p.Creatures...), p.Artifacts...), card)
+
+	if e != nil {
+		errorMessage := fmt.Sprintf("%s is not in play under %s's control", card.CardTitle, p.Name)
+		return errors.New(errorMessage)
+	}
+
+	trigger := TriggerAction
+
+	if g.UsesOmni(stored) {
+		trigger = TriggerOmni
+	} else if !g.IsActiveHouse(stored) || !hasAbility(stored, "Action:") {
+		errorMessage := fmt.Sprintf("%s has no ability that can be used", stored.CardTitle)
+		return errors.New(errorMessage)
+	}
+
+	used, usable, e := g.exhaustInPlay(p, stored)
+
+	if e != nil || !usable {
+		return e
+	}
+
+	fmt.Println(p.Name, "uses", used.CardTitle)
+	g.Emit(Event{Type: EventCardUsed, Player: p, Card: *used})
+	g.ResolveAbilities(p, *used, trigger)
+
+	return nil
+}
+
+// Stun - Stuns a creature in play. The creature on the board is stunned
+// rather than the given copy.
+func (g *Game) Stun(creature Card) error {
+	holder, zone, e := LocateCard(creature)
+
+	if e != nil {
+		return e
+	}
+
+	if zone != ZoneCreatures {
+		errorMessage := fmt.Sprintf("cannot stun %s, card is not a creature in play", creature.CardTitle)
+		return errors.New(errorMessage)
+	}
+
+	target, e := holder.FindCreature(creature)
+
+	if e != nil {
+		return e
+	}
+
+	target.Stun()
+	fmt.Println(target.CardTitle, "is stunned.")
+	g.Emit(Event{Type: EventCardStunned, Player: holder, Card: *target})
+
+	return nil
+}
+
+// RemoveStun - Uses a stunned creature. Instead of reaping, fighting or
+// using an action ability the creature is exhausted and its stun removed.
+// Reaping, fighting or using a stunned creature has the same result.
+func (g *Game) RemoveStun(p *Player, creature Card) error {
+	stunned, e := p.FindCreature(creature)
+
+	if e != nil {
+		return e
+	}
+
+	if !stunned.IsStunned {
+		errorMessage := fmt.Sprintf("%s is not stunned", stunned.CardTitle)
+		return errors.New(errorMessage)
+	}
+
+	if stunned.IsExhausted {
+		errorMessage := fmt.Sprintf("%s is exhausted", stunned.CardTitle)
+		return errors.New(errorMessage)
+	}
+
+	e = g.RecordUse(*stunned)
+
+	if e != nil {
+		return e
+	}
+
+	g.removeStun(p, stunned)
+	return nil
+}
+
+// removeStun - Exhausts a stunned creature and removes its stun. The
+// creature must already have been recorded as used.
+func (g *Game) removeStun(p *Player, stunned *Card) {
+	stunned.IsStunned = false
+	stunned.IsExhausted = true
+
+	fmt.Println(p.Name, "removes the stun from", stunned.CardTitle)
+	g.Emit(Event{Type: EventStunRemoved, Player: p, Card: *stunned})
+}
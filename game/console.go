@@ -0,0 +1,233 @@
+package keyforge
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ConsoleDecisions - DecisionProvider which lets a person make each choice.
+// Prompts are written to Writer and answers are read line by line from
+// Reader, which makes it usable in a terminal as well as from scripted
+// input. Once Reader is exhausted every remaining choice is passed.
+type ConsoleDecisions struct {
+	Reader *bufio.Scanner
+	Writer io.Writer
+}
+
+// NewConsoleDecisions - Returns a pointer to a new console decision provider.
+func NewConsoleDecisions(r io.Reader, w io.Writer) *ConsoleDecisions {
+	console := new(ConsoleDecisions)
+	console.Reader = bufio.NewScanner(r)
+	console.Writer = w
+
+	return console
+}
+
+// DescribeCard - Returns a short one line description of a card.
+func DescribeCard(card Card) string {
+	description := fmt.Sprintf("%s (%s %s)", card.CardTitle, card.House, card.CardType)
+
+	if strings.ToLower(card.CardType) == "creature" {
+		description += fmt.Sprintf(" power %d", card.Power)
+	}
+
+	if card.IsExhausted {
+		description += " [exhausted]"
+	}
+
+	if card.IsStunned {
+		description += " [stunned]"
+	}
+
+	return description
+}
+
+// readLine - Reads the next trimmed line of input. Returns false once the
+// input has been exhausted.
+func (c *ConsoleDecisions) readLine() (string, bool) {
+	if !c.Reader.Scan() {
+		return "", false
+	}
+
+	return strings.TrimSpace(c.Reader.Text()), true
+}
+
+// choose - Lists options and reads the number of the chosen option. When
+// pass is true the player may answer 0 to choose nothing, in which case -1
+// is returned.
+func (c *ConsoleDecisions) choose(prompt string, options []string, pass bool) int {
+	for {
+		fmt.Fprintln(c.Writer, prompt)
+
+		if pass {
+			fmt.Fprintln(c.Writer, "  0) Pass")
+		}
+
+		for i, option := range options {
+			fmt.Fprintf(c.Writer, "  %d) %s\n", i+1, option)
+		}
+
+		line, ok := c.readLine()
+
+		if !ok {
+			if pass || len(options) == 0 {
+				return -1
+			}
+			return 0
+		}
+
+		choice, e := strconv.Atoi(line)
+
+		if e == nil && choice == 0 && pass {
+			return -1
+		}
+
+		if e == nil && choice > 0 && choice <= len(options) {
+			return choice - 1
+		}
+
+		fmt.Fprintln(c.Writer, "Invalid choice:", line)
+	}
+}
+
+// confirm - Asks a yes or no question. Exhausted input answers no.
+func (c *ConsoleDecisions) confirm(prompt string) bool {
+	for {
+		fmt.Fprintf(c.Writer, "%s (y/n)\n", prompt)
+
+		line, ok := c.readLine()
+
+		if !ok {
+			return false
+		}
+
+		switch strings.ToLower(line) {
+		case "y", "yes":
+			return true
+		case "n", "no":
+			return false
+		}
+
+		fmt.Fprintln(c.Writer, "Please answer y or n.")
+	}
+}
+
+// chooseCard - Lists cards and reads the chosen card.
+func (c *ConsoleDecisions) chooseCard(prompt string, cards []Card) (Card, bool) {
+	options := []string{}
+
+	for _, card := range cards {
+		options = append(options, DescribeCard(card))
+	}
+
+	choice := c.choose(prompt, options, true)
+
+	if choice < 0 {
+		return Card{}, false
+	}
+
+	return cards[choice], true
+}
+
+// printStatus - Writes a summary of the player's position.
+func (c *ConsoleDecisions) printStatus(p *Player) {
+	fmt.Fprintf(c.Writer, "%s: %d aember, %d keys, %d cards in hand, %d in archives\n",
+		p.Name, p.Amber, p.Keys, len(p.HandPile), len(p.ArchivePile))
+
+	if p.Game != nil {
+		if opponent := p.Game.Opponent(p); opponent != nil {
+			fmt.Fprintf(c.Writer, "%s: %d aember, %d keys, %d creatures, %d artifacts\n",
+				opponent.Name, opponent.Amber, opponent.Keys, len(opponent.Creatures), len(opponent.Artifacts))
+		}
+	}
+}
+
+// ChooseMulligan - Shows the opening hand and asks whether to mulligan.
+func (c *ConsoleDecisions) ChooseMulligan(p *Player) bool {
+	fmt.Fprintln(c.Writer, "Your opening hand:")
+
+	for _, card := range p.HandPile {
+		fmt.Fprintln(c.Writer, " ", DescribeCard(card))
+	}
+
+	return c.confirm("Mulligan?")
+}
+
+// ChooseTakeArchives - Asks whether to pick up archives.
+func (c *ConsoleDecisions) ChooseTakeArchives(p *Player) bool {
+	fmt.Fprintln(c.Writer, "Your archives:")
+
+	for _, card := range p.ArchivePile {
+		fmt.Fprintln(c.Writer, " ", DescribeCard(card))
+	}
+
+	return c.confirm("Take your archives into hand?")
+}
+
+// ChooseHouse - Shows the hand and asks for the active house.
+func (c *ConsoleDecisions) ChooseHouse(p *Player, houses []string) string {
+	c.printStatus(p)
+	fmt.Fprintln(c.Writer, "Your hand:")
+
+	for _, card := range p.HandPile {
+		fmt.Fprintln(c.Writer, " ", DescribeCard(card))
+	}
+
+	if len(houses) == 0 {
+		return ""
+	}
+
+	return houses[c.choose("Choose a house:", houses, false)]
+}
+
+// ChooseAction - Lists every legal action and asks which to take. Passing
+// ends the turn.
+func (c *ConsoleDecisions) ChooseAction(p *Player, actions []Action) Action {
+	options := []string{}
+	choices := []Action{}
+
+	for _, action := range actions {
+		if action.Type != ActionEndTurn {
+			options = append(options, action.String())
+			choices = append(choices, action)
+		}
+	}
+
+	c.printStatus(p)
+	choice := c.choose("Choose an action (pass to end your turn):", options, true)
+
+	if choice < 0 {
+		return Action{Type: ActionEndTurn, Player: p}
+	}
+
+	return choices[choice]
+}
+
+// ChooseTrigger - Asks which triggered ability resolves next.
+func (c *ConsoleDecisions) ChooseTrigger(p *Player, cards []Card) (Card, bool) {
+	return c.chooseCard("Choose the next ability to resolve:", cards)
+}
+
+// ChooseTarget - Asks for the target of an effect.
+func (c *ConsoleDecisions) ChooseTarget(p *Player, prompt string, targets []Card) (Card, bool) {
+	return c.chooseCard(prompt, targets)
+}
+
+// ChooseFlank - Asks which flank to deploy a creature on.
+func (c *ConsoleDecisions) ChooseFlank(p *Player, card Card) Flank {
+	prompt := fmt.Sprintf("Deploy %s on which flank?", card.CardTitle)
+
+	if c.choose(prompt, []string{"Left", "Right"}, false) == 0 {
+		return LeftFlank
+	}
+
+	return RightFlank
+}
+
+// ChooseOptional - Asks whether to resolve an optional effect.
+func (c *ConsoleDecisions) ChooseOptional(p *Player, prompt string) bool {
+	return c.confirm(prompt)
+}
@@ -0,0 +1,74 @@
+package keyforge
+
+// DecisionProvider - This interface is implemented by anything able to make
+// the choices a player faces during a game. Bots implement it with their
+// own heuristics while ConsoleDecisions asks a person. Methods returning a
+// bool alongside a card return false when the player chooses to pass.
+type DecisionProvider interface {
+	// ChooseMulligan - Return true to mulligan the opening hand.
+	ChooseMulligan(p *Player) bool
+
+	// ChooseTakeArchives - Return true to pick up archives into hand.
+	ChooseTakeArchives(p *Player) bool
+
+	// ChooseHouse - Choose the active house for the turn.
+	ChooseHouse(p *Player, houses []string) string
+
+	// ChooseAction - Choose the next action to take during the main step of
+	// the turn. The actions offered always include ending the turn.
+	ChooseAction(p *Player, actions []Action) Action
+
+	// ChooseTarget - Choose a target for an effect.
+	ChooseTarget(p *Player, prompt string, targets []Card) (Card, bool)
+
+	// ChooseFlank - Choose the flank a creature is deployed on.
+	ChooseFlank(p *Player, card Card) Flank
+
+	// ChooseOptional - Decide whether to resolve an optional "may" effect.
+	ChooseOptional(p *Player, prompt string) bool
+
+	// ChooseTrigger - Choose which of several abilities that triggered at
+	// the same time resolves next.
+	ChooseTrigger(p *Player, cards []Card) (Card, bool)
+}
+
+// passDecisions - Decision provider used for players that have not been
+// given one. It keeps its hand, picks the first house offered and passes on
+// every other choice.
+type passDecisions struct{}
+
+func (passDecisions) ChooseMulligan(p *Player) bool { return false }
+
+func (passDecisions) ChooseTakeArchives(p *Player) bool { return false }
+
+func (passDecisions) ChooseHouse(p *Player, houses []string) string {
+	if len(houses) == 0 {
+		return ""
+	}
+
+	return houses[0]
+}
+
+func (passDecisions) ChooseAction(p *Player, actions []Action) Action {
+	return Action{Type: ActionEndTurn, Player: p}
+}
+
+func (passDecisions) ChooseTarget(p *Player, prompt string, targets []Card) (Card, bool) {
+	return Card{}, false
+}
+
+func (passDecisions) ChooseFlank(p *Player, card Card) Flank { return RightFlank }
+
+func (passDecisions) ChooseOptional(p *Player, prompt string) bool { return false }
+
+func (passDecisions) ChooseTrigger(p *Player, cards []Card) (Card, bool) { return Card{}, false }
+
+// decisions - Returns the player's decision provider, falling back to one
+// that passes on everything.
+func (p *Player) decisions() DecisionProvider {
+	if p.Decisions == nil {
+		return passDecisions{}
+	}
+
+	return p.Decisions
+}
@@ -8,21 +8,22 @@ import (
 
 // Deck - Type used for containing deck information, including card data.
 type Deck struct {
-	Name          string   `json:"name"`
-	Expansion     int      `json:"expansion"`
-	Chains        int      `json:"chains"`
-	Wins          int      `json:"wins"`
-	Losses        int      `json:"losses"`
-	ID            string   `json:"id"`
-	IsMyDeck      bool     `json:"is_my_deck"`
-	Notes         []string `json:"notes"`
-	IsMyFavorite  bool     `json:"is_my_favorite"`
-	IsOnWatchList bool     `json:"is_on_my_watchlist"`
-	CasualWins    int      `json:"casual_wins"`
-	CasualLosses  int      `json:"casual_losses"`
-	Cards         []Card   `json:"cards"`
-	Houses        []string `json:"houses"`
-	CardList      []string `json:"card_list"`
+	Name          string            `json:"name"`
+	Expansion     int               `json:"expansion"`
+	Chains        int               `json:"chains"`
+	Wins          int               `json:"wins"`
+	Losses        int               `json:"losses"`
+	ID            string            `json:"id"`
+	IsMyDeck      bool              `json:"is_my_deck"`
+	Notes         []string          `json:"notes"`
+	IsMyFavorite  bool              `json:"is_my_favorite"`
+	IsOnWatchList bool              `json:"is_on_my_watchlist"`
+	CasualWins    int               `json:"casual_wins"`
+	CasualLosses  int               `json:"casual_losses"`
+	Cards         []Card            `json:"cards"`
+	Houses        []string          `json:"houses"`
+	HouseImages   map[string]string `json:"house_images"`
+	CardList      []string          `json:"card_list"`
 }
 
 // LoadDeckFromFile - Load deck data from file contents.
@@ -0,0 +1,65 @@
+package keyforge
+
+// EventType - Identifies the kind of event emitted by a game.
+type EventType string
+
+// Event types emitted by the game engine.
+const (
+	EventAmberGained   EventType = "amber_gained"
+	EventAmberLost     EventType = "amber_lost"
+	EventAmberStolen   EventType = "amber_stolen"
+	EventAmberCaptured EventType = "amber_captured"
+	EventAmberReleased EventType = "amber_released"
+	EventKeyForged     EventType = "key_forged"
+	EventHandDealt     EventType = "hand_dealt"
+	EventHandKept      EventType = "hand_kept"
+	EventMulligan      EventType = "mulligan"
+	EventHouseChosen   EventType = "house_chosen"
+	EventReap          EventType = "reap"
+	EventFight         EventType = "fight"
+	EventCardUsed      EventType = "card_used"
+	EventDamageDealt   EventType = "damage_dealt"
+	EventCardDestroyed EventType = "card_destroyed"
+	EventCardStunned   EventType = "card_stunned"
+	EventStunRemoved   EventType = "stun_removed"
+)
+
+// Event - This type describes something that happened during a game.
+// Player is the player the event happened to or who caused it, Target is
+// the other player involved (if any) and Card is the card involved (if any).
+type Event struct {
+	Type   EventType
+	Player *Player
+	Target *Player
+	Card   Card
+	Amount int
+}
+
+// EventHandler - Function type used to receive events as they are emitted.
+type EventHandler func(event Event)
+
+// Subscribe - Registers a handler which will be called for every event
+// emitted by the game from this point on.
+func (g *Game) Subscribe(handler EventHandler) {
+	g.EventHandlers = append(g.EventHandlers, handler)
+}
+
+// Emit - Records an event in the game's event log and passes it to every
+// subscribed handler.
+func (g *Game) Emit(event Event) {
+	g.Events = append(g.Events, event)
+
+	for _, handler := range g.EventHandlers {
+		handler(event)
+	}
+}
+
+// emit - Emits an event through the player's game. Players that have not
+// joined a game silently drop their events.
+func (p *Player) emit(event Event) {
+	if p.Game == nil {
+		return
+	}
+
+	p.Game.Emit(event)
+}
@@ -7,14 +7,25 @@ import (
 )
 
 type Game struct {
-	Running    bool
-	Debug      bool
-	Simulation bool
-	Seed       int64
-	Turn       int
-	Round      int
-	Players    []Player
-	Bots       []*Bot
+	Running        bool
+	Debug          bool
+	Simulation     bool
+	Seed           int64
+	Turn           int
+	Round          int
+	FirstPlayer    *Player
+	ActivePlayer   *Player
+	ActiveHouse    string
+	Step           Step
+	Players        []*Player
+	Bots           []*Bot
+	TurnState      *TurnState
+	LastingEffects []LastingEffect
+	Events         []Event
+	EventHandlers  []EventHandler
+
+	pendingTriggers []PendingTrigger
+	windowDepth     int
 }
 
 type BoardState struct {
@@ -25,10 +36,13 @@ func NewGame() *Game {
 	return game
 }
 
+// Start - Runs a game from the coin toss until a player wins. Players and
+// bots added before calling Start take part in the game; if none have been
+// added, two bots playing the test deck are created and the game is run as
+// a simulation.
 func (g *Game) Start() {
 	g.Running = true
 	g.Debug = true
-	g.Simulation = true
 
 	if g.Seed == 0 {
 		rand.Seed(time.Now().UTC().UnixNano())
@@ -36,23 +50,23 @@ func (g *Game) Start() {
 		rand.Seed(g.Seed)
 	}
 
-	deck, e := LoadDeckFromFile("../test/test_data/test_deck.json")
+	if len(g.Players) == 0 {
+		g.Simulation = true
 
-	if e != nil {
-		fmt.Println(e)
-		return
-	}
+		deck, e := LoadDeckFromFile("../test/test_data/test_deck.json")
+
+		if e != nil {
+			fmt.Println(e)
+			return
+		}
 
-	if g.Simulation {
 		playerOne := NewBot()
 		playerOne.Name = "Player one"
 		playerOne.SetDeck(deck)
-		playerOne.ShuffleDrawPile()
 
 		playerTwo := NewBot()
 		playerTwo.Name = "Player two"
 		playerTwo.SetDeck(deck)
-		playerTwo.ShuffleDrawPile()
 
 		g.AddBot(playerOne)
 		g.AddBot(playerTwo)
@@ -60,44 +74,19 @@ func (g *Game) Start() {
 
 	g.DetermineFirstPlayer()
 
-	// draw up cards, determine mulligan
-	if g.Simulation {
-		for i := range g.Bots {
-			bot := g.Bots[i]
-			bot.DrawHand()
-
-			if bot.FirstTurn {
-				fmt.Println(bot.Name, "drawing an additional card for winning the toss.")
-				bot.DrawCard()
-			}
-
-			if bot.DetermineMulligan() {
-				fmt.Println(bot.Name, "chose to mulligan.")
+	g.DealOpeningHands()
 
-				bot.SetDeck(deck)
-				bot.ShuffleDrawPile()
-
-				for i := 0; i < 5; i++ {
-					bot.DrawCard()
-				}
-
-				if bot.FirstTurn {
-					bot.DrawCard()
-				}
+	if g.Simulation {
+		for _, player := range g.Players {
+			fmt.Println("Opening hand for", player.Name)
+			for _, card := range player.HandPile {
+				fmt.Println(card.CardTitle)
 			}
-
-		}
-	}
-
-	for _, bot := range g.Bots {
-		fmt.Println("Opening hand for", bot.Name)
-		//fmt.Println(len(bot.HandPile))
-		for _, card := range bot.HandPile {
-			fmt.Println(card.CardTitle)
 		}
 	}
 
 	for g.Running {
+		g.Turn = 1
 		g.Round = 1
 		g.GameLoop()
 		fmt.Println("#### Game results ####")
@@ -106,13 +95,65 @@ func (g *Game) Start() {
 	}
 }
 
+// DetermineFirstPlayer - Randomly selects the player who takes the first
+// turn of the game.
 func (g *Game) DetermineFirstPlayer() {
-	if g.Simulation {
-		roll := rand.Intn(len(g.Bots) - 1)
-		bot := g.Bots[roll]
-		bot.FirstTurn = true
-		fmt.Println(bot.Name, "won the toss!")
+	if len(g.Players) == 0 {
+		return
 	}
+
+	roll := rand.Intn(len(g.Players))
+	g.FirstPlayer = g.Players[roll]
+	fmt.Println(g.FirstPlayer.Name, "won the toss!")
+}
+
+// IsFirstPlayer - Returns true if the given player takes the first turn of
+// the game.
+func (g *Game) IsFirstPlayer(p *Player) bool {
+	return g.FirstPlayer == p
+}
+
+// DealOpeningHands - Deals each player their opening hand, starting with
+// the first player. The first player is dealt one card more than the other
+// player. Each player may then mulligan once, shuffling their hand back into
+// their deck and drawing one card fewer.
+func (g *Game) DealOpeningHands() {
+	for _, player := range g.PlayersInTurnOrder() {
+		size := OpeningHandSize
+
+		if g.IsFirstPlayer(player) {
+			size++
+		}
+
+		player.ShuffleDrawPile()
+		player.DrawCards(size)
+		g.Emit(Event{Type: EventHandDealt, Player: player, Amount: size})
+
+		if !player.decisions().ChooseMulligan(player) {
+			g.Emit(Event{Type: EventHandKept, Player: player, Amount: len(player.HandPile)})
+			continue
+		}
+
+		fmt.Println(player.Name, "chose to mulligan.")
+		player.Mulligan()
+		g.Emit(Event{Type: EventMulligan, Player: player, Amount: len(player.HandPile)})
+	}
+}
+
+// PlayersInTurnOrder - Returns the game's players with the first player
+// first.
+func (g *Game) PlayersInTurnOrder() []*Player {
+	players := []*Player{}
+
+	for _, player := range g.Players {
+		if g.IsFirstPlayer(player) {
+			players = append([]*Player{player}, players...)
+		} else {
+			players = append(players, player)
+		}
+	}
+
+	return players
 }
 
 func (g *Game) RollDice() (int, int) {
@@ -120,14 +161,72 @@ func (g *Game) RollDice() (int, int) {
 }
 
 func (g *Game) AddPlayer(p *Player) {
-	g.Players = append(g.Players, *p)
+	p.Game = g
+	g.Players = append(g.Players, p)
 }
 
+// AddBot - Adds a bot to the game. The bot's embedded player is registered
+// alongside any other players so that card ownership can be resolved.
 func (g *Game) AddBot(b *Bot) {
+	b.Game = g
 	g.Bots = append(g.Bots, b)
+	g.Players = append(g.Players, &b.Player)
 }
 
+// Opponent - Returns the player opposing the given player, or nil if the
+// game does not have an opponent for them.
+func (g *Game) Opponent(p *Player) *Player {
+	for _, player := range g.Players {
+		if player != p {
+			return player
+		}
+	}
+
+	return nil
+}
+
+// PurgedCards - Returns every card purged during the game, regardless of
+// which player owns it.
+func (g *Game) PurgedCards() []Card {
+	purged := []Card{}
+
+	for _, player := range g.Players {
+		purged = append(purged, player.PurgePile...)
+	}
+
+	return purged
+}
+
+// CreaturesInPlay - Returns every creature in play, in turn order of their
+// controllers.
+func (g *Game) CreaturesInPlay() []Card {
+	creatures := []Card{}
+
+	for _, player := range g.PlayersInTurnOrder() {
+		creatures = append(creatures, player.Creatures...)
+	}
+
+	return creatures
+}
+
+// PurgedCardsByPlayer - Returns purged cards grouped by the player who owns
+// them. This is mostly useful for tracking which decks lose key cards to
+// purge effects across many simulated games; each player's deck is in
+// PlayerDeck, and keying by player keeps mirror matches apart.
+func (g *Game) PurgedCardsByPlayer() map[*Player][]Card {
+	purged := map[*Player][]Card{}
+
+	for _, player := range g.Players {
+		purged[player] = append([]Card{}, player.PurgePile...)
+	}
+
+	return purged
+}
+
+// GameLoop - Plays rounds until the game has a winner.
 func (g *Game) GameLoop() {
+	g.BeginTurn(g.FirstPlayer)
+
 	for g.Running {
 		g.ExecuteTurn()
 	}
@@ -137,54 +236,67 @@ func (g *Game) ExecuteRound() {
 
 }
 
+// ExecuteTurn - Plays one turn for each player in turn order.
 func (g *Game) ExecuteTurn() {
-	var firstPlayer *Bot
-	var secondPlayer *Bot
-	g.Turn = 1
+	for range g.Players {
+		g.PlayTurn()
 
-	for _, bot := range g.Bots {
-		if bot.FirstTurn {
-			firstPlayer = bot
-		} else {
-			secondPlayer = bot
+		if !g.Running {
+			return
 		}
 	}
 
-	if firstPlayer.Amber > 6 {
-		firstPlayer.ForgeKey()
-	}
+	g.Round++
+}
 
-	if firstPlayer.Keys > 2 {
-		fmt.Println("")
-		fmt.Println("PLAYER ONE WINS THE GAME!")
-		g.Running = false
-		return
-	}
+// PlayTurn - Asks the active player for decisions until their turn ends.
+// The player chooses a house and then takes legal actions one at a time
+// until they end their turn.
+func (g *Game) PlayTurn() {
+	p := g.ActivePlayer
+
+	for g.Running && g.ActivePlayer == p && g.Step != StepNone {
+		if g.Step == StepChooseHouse {
+			g.ChooseHouse(p)
+			continue
+		}
+
+		action := p.decisions().ChooseAction(p, g.LegalActions())
+		e := g.Apply(action)
+
+		if e != nil {
+			fmt.Println(e)
+			g.Apply(Action{Type: ActionEndTurn, Player: p})
+		}
 
-	house := firstPlayer.DetermineActiveHouse()
-	fmt.Println(firstPlayer.Name, "chose house", house)
-	firstPlayer.PlayCards(house)
-	firstPlayer.DrawHand()
-	firstPlayer.PrettyPrintHand()
+		if action.Type == ActionEndTurn {
+			return
+		}
+	}
+}
 
-	g.Turn++
+// ChooseHouse - Runs the house choice step of a player's turn. The player
+// may first pick up their archives before declaring the active house.
+func (g *Game) ChooseHouse(p *Player) string {
+	decisions := p.decisions()
 
-	if secondPlayer.Amber > 6 {
-		secondPlayer.ForgeKey()
+	if len(p.ArchivePile) > 0 && decisions.ChooseTakeArchives(p) {
+		g.Apply(Action{Type: ActionTakeArchives, Player: p})
 	}
 
-	if secondPlayer.Keys > 2 {
-		fmt.Println("")
-		fmt.Println("PLAYER TWO WINS THE GAME!")
-		g.Running = false
-		return
+	houses := GetHouses(p.PlayerDeck.Cards)
+	house := decisions.ChooseHouse(p, houses)
+
+	if !HouseExists(houses, house) && len(houses) > 0 {
+		house = houses[0]
 	}
 
-	house = secondPlayer.DetermineActiveHouse()
-	fmt.Println(secondPlayer.Name, "chose house", house)
-	secondPlayer.PlayCards(house)
-	secondPlayer.DrawHand()
-	secondPlayer.PrettyPrintHand()
+	e := g.Apply(Action{Type: ActionChooseHouse, Player: p, House: house})
 
-	g.Round++
+	if e != nil {
+		fmt.Println(e)
+		g.Step = StepMain
+	}
+
+	return house
 }
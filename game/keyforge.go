@@ -14,17 +14,3 @@ func HouseExists(array []string, house string) bool {
 	}
 	return false
 }
-
-// PrepareDrawPile - This function sets up a player's initial hand.
-func PrepareDrawPile(player *Player) {
-	player.DrawPile = nil
-	player.DrawPile = append(player.DrawPile, player.PlayerDeck.Cards...)
-
-	for i := 0; i < 10; i++ {
-		Shuffle(player.DrawPile)
-	}
-
-	for i := 0; i < 6; i++ {
-		player.DrawCard()
-	}
-}
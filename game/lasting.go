@@ -0,0 +1,167 @@
+package keyforge
+
+// Duration - How long a lasting effect stays active.
+type Duration int
+
+// Lasting effect durations.
+//
+// DurationThisTurn - "for the remainder of the turn".
+// DurationOpponentsNextTurn - "until the end of your opponent's next turn".
+// DurationYourNextTurn - "during your next turn"; inactive until then.
+// DurationWhileInPlay - "while this is in play"; ends when the source card
+// leaves play.
+const (
+	DurationThisTurn Duration = iota
+	DurationOpponentsNextTurn
+	DurationYourNextTurn
+	DurationWhileInPlay
+)
+
+// LastingEffect - A modifier applied to cards in play for a limited time.
+// The effect applies to the card with Target's instance ID if one is set,
+// otherwise to every card in play matching Scope relative to Controller.
+// Power limits in Scope are ignored since power is itself derived from
+// lasting effects.
+type LastingEffect struct {
+	Source        Card
+	Controller    *Player
+	Target        Card
+	Scope         TargetConstraint
+	Duration      Duration
+	PowerModifier int
+	ArmorModifier int
+	PreventDamage bool
+	StartTurn     int
+	EndTurn       int
+}
+
+// foreverTurn - End turn used by effects that only end when their source
+// leaves play.
+const foreverTurn = int(^uint(0) >> 1)
+
+// AddLastingEffect - Registers a lasting effect starting on the current
+// turn. The turns the effect is active for are worked out from its duration.
+func (g *Game) AddLastingEffect(effect LastingEffect) {
+	effect.StartTurn = g.Turn
+	effect.EndTurn = g.Turn
+
+	switch effect.Duration {
+	case DurationOpponentsNextTurn:
+		effect.EndTurn = g.Turn + 1
+	case DurationYourNextTurn:
+		effect.StartTurn = g.Turn + len(g.Players)
+		effect.EndTurn = effect.StartTurn
+	case DurationWhileInPlay:
+		effect.EndTurn = foreverTurn
+	}
+
+	g.LastingEffects = append(g.LastingEffects, effect)
+}
+
+// IsActive - Determine whether a lasting effect applies during the given
+// turn.
+func (l LastingEffect) IsActive(turn int) bool {
+	return turn >= l.StartTurn && turn <= l.EndTurn
+}
+
+// Affects - Determine whether a lasting effect applies to a card in play.
+func (l LastingEffect) Affects(g *Game, card Card) bool {
+	if l.Target.InstanceID != 0 {
+		return l.Target.IsSameCard(card)
+	}
+
+	scope := l.Scope
+	scope.MinimumPower = 0
+	scope.MaximumPower = 0
+
+	return scope.Allows(g, l.Controller, card.Controller, card)
+}
+
+// ActiveEffects - Returns the lasting effects currently applying to a card.
+func (g *Game) ActiveEffects(card Card) []LastingEffect {
+	active := []LastingEffect{}
+
+	for _, effect := range g.LastingEffects {
+		if effect.IsActive(g.Turn) && effect.Affects(g, card) {
+			active = append(active, effect)
+		}
+	}
+
+	return active
+}
+
+// Power - Returns a creature's current power, including lasting effects.
+func (g *Game) Power(card Card) int {
+	power := card.Power + card.PowerBonus
+
+	for _, effect := range g.ActiveEffects(card) {
+		power += effect.PowerModifier
+	}
+
+	if power < 0 {
+		return 0
+	}
+
+	return power
+}
+
+// Armor - Returns a creature's current armor, including lasting effects.
+func (g *Game) Armor(card Card) int {
+	armor := card.Armor + card.ArmorBonus
+
+	for _, effect := range g.ActiveEffects(card) {
+		armor += effect.ArmorModifier
+	}
+
+	if armor < 0 {
+		return 0
+	}
+
+	return armor
+}
+
+// PreventsDamage - Determine whether a lasting effect stops a creature from
+// being dealt damage.
+func (g *Game) PreventsDamage(card Card) bool {
+	for _, effect := range g.ActiveEffects(card) {
+		if effect.PreventDamage {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expireLastingEffects - Removes lasting effects which end with the current
+// turn. Called as the turn ends.
+func (g *Game) expireLastingEffects() {
+	remaining := []LastingEffect{}
+
+	for _, effect := range g.LastingEffects {
+		if effect.EndTurn > g.Turn {
+			remaining = append(remaining, effect)
+		}
+	}
+
+	g.LastingEffects = remaining
+}
+
+// removeLastingEffects - Removes lasting effects tied to a card leaving
+// play: effects lasting while the card is in play and effects targeting it.
+func (g *Game) removeLastingEffects(card Card) {
+	remaining := []LastingEffect{}
+
+	for _, effect := range g.LastingEffects {
+		if effect.Duration == DurationWhileInPlay && effect.Source.IsSameCard(card) {
+			continue
+		}
+
+		if effect.Target.InstanceID != 0 && effect.Target.IsSameCard(card) {
+			continue
+		}
+
+		remaining = append(remaining, effect)
+	}
+
+	g.LastingEffects = remaining
+}
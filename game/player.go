@@ -1,6 +1,13 @@
 package keyforge
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
+
+// OpeningHandSize - The number of cards dealt to the second player at the
+// start of a game. The first player is dealt one additional card.
+const OpeningHandSize = 6
 
 // Player - This struct represents players within the game. This type is
 // also the foundation of the Bot class, as it implements most if not all
@@ -17,10 +24,10 @@ type Player struct {
 	PurgePile   []Card
 	Artifacts   []Card
 	Creatures   []Card
-	FirstTurn   bool
 	Amber       int
 	Keys        int
 	Chains      int
+	Decisions   DecisionProvider
 }
 
 // NewPlayer - Returns a pointer to a new player object.
@@ -30,6 +37,7 @@ func NewPlayer() *Player {
 	player.HandPile = make([]Card, 0)
 	player.ArchivePile = make([]Card, 0)
 	player.DiscardPile = make([]Card, 0)
+	player.PurgePile = make([]Card, 0)
 
 	return player
 }
@@ -47,11 +55,15 @@ func (p *Player) PrettyPrintHand() {
 
 // SetDeck - Sets a player's deck. If a deck has already been defined it will
 // be cleared and replaced with the specified deck. This function is mainly
-// useful for setting up players at the beginning of a game.
+// useful for setting up players at the beginning of a game. Cards placed in
+// the draw pile are new instances owned by this player.
 func (p *Player) SetDeck(deck Deck) {
 	p.PlayerDeck = deck
 	p.DrawPile = nil
-	p.DrawPile = append(p.DrawPile, p.PlayerDeck.Cards...)
+
+	for _, card := range p.PlayerDeck.Cards {
+		p.DrawPile = append(p.DrawPile, NewCardInstance(card, p))
+	}
 }
 
 // ShuffleDrawPile - This function shuffles the player's draw pile (surprise).
@@ -66,28 +78,89 @@ func (p *Player) ShuffleDrawPile() {
 // empty this function automatically shuffles the discard pile back into
 // the draw pile.
 func (p *Player) DrawCard() {
-	count := len(p.DrawPile)
-	index := len(p.DrawPile) - 1
-
-	if index < 0 {
-		index = 0
-	}
-
-	if count == 0 {
+	if len(p.DrawPile) == 0 {
 		fmt.Println("Draw pile empty, shuffling into discard.")
 		p.ShuffleDiscardPile()
 	}
 
+	// Both piles may be empty when most of the deck is in play.
+	if len(p.DrawPile) == 0 {
+		return
+	}
+
+	index := len(p.DrawPile) - 1
 	card := p.DrawPile[index]
 	p.DrawPile = PopCard(p.DrawPile)
 	p.HandPile = AddCard(p.HandPile, card)
 }
 
+// DrawCards - Draws the given number of cards from the draw pile into the
+// player's hand.
+func (p *Player) DrawCards(count int) {
+	for i := 0; i < count; i++ {
+		p.DrawCard()
+	}
+}
+
+// Mulligan - Shuffles the player's hand back into their draw pile and draws
+// a new hand containing one fewer card.
+func (p *Player) Mulligan() {
+	size := len(p.HandPile) - 1
+
+	p.DrawPile = append(p.DrawPile, p.HandPile...)
+	p.HandPile = nil
+	p.ShuffleDrawPile()
+	p.DrawCards(size)
+}
+
 // Discard - Discard a card from the player's hand. Cards discarded in
-// this manner are sent to the player's discard pile.
+// this manner are sent to their owner's discard pile. On the first turn of
+// the game the first player may only discard if they have not yet played or
+// discarded a card.
 func (p *Player) Discard(card Card) {
+	if p.Game != nil {
+		if !p.Game.CanPlayOrDiscard(p) {
+			fmt.Println(p.Name, "cannot play or discard any more cards on the first turn.")
+			return
+		}
+
+		p.Game.RecordDiscard(card)
+	}
+
+	owner := ownerOf(card, p)
 	p.HandPile = RemoveCard(p.HandPile, card)
-	p.DiscardPile = AddCard(p.DiscardPile, card)
+	owner.DiscardPile = AddCard(owner.DiscardPile, card)
+}
+
+// Archive - Archive a card from the player's hand. Archived cards stay
+// facedown in the player's archives until they are taken back into hand.
+func (p *Player) Archive(card Card) error {
+	foundCard, e := findSameCard(p.HandPile, card)
+
+	if e != nil {
+		return e
+	}
+
+	p.HandPile = RemoveCard(p.HandPile, foundCard)
+	p.ArchivePile = AddCard(p.ArchivePile, foundCard)
+
+	if p.Debug {
+		fmt.Println(p.Name, "archived", foundCard.CardTitle)
+	}
+
+	return nil
+}
+
+// TakeArchives - Pick up every card in the player's archives and add them to
+// the player's hand. Cards owned by an opponent are returned to their
+// owner's hand instead.
+func (p *Player) TakeArchives() {
+	for _, card := range p.ArchivePile {
+		owner := ownerOf(card, p)
+		owner.HandPile = AddCard(owner.HandPile, card)
+	}
+
+	p.ArchivePile = nil
 }
 
 // ShuffleDiscardPile - This function transfers the contents of the discard
@@ -122,28 +195,64 @@ func (p *Player) DrawHand() {
 	}
 }
 
-// PlayCard - Play a card from the player's hand onto the board.
-// TODO: Add logic to account for creatures and artifacts. Right now during
-// simulated play they pretty much go directly to the discard pile rather
-// than being used for reaping/amber gain/etc.
+// PlayCard - Play a card from the player's hand. Bonus aember is gained
+// first. Creatures are then deployed to a flank of the player's battleline
+// and artifacts are put into play; both enter play exhausted. Any "Play:"
+// abilities resolve next, after which other cards are sent to their owner's
+// discard pile.
+// TODO: Upgrades should be attached to a creature rather than discarded.
 func (p *Player) PlayCard(card Card) {
-	foundCard, e := FindCardByID(p.HandPile, card.ID)
+	foundCard, e := findSameCard(p.HandPile, card)
 
 	if e != nil {
 		fmt.Println(e)
 		return
 	}
 
+	if p.Game != nil {
+		if !p.Game.CanPlayOrDiscard(p) {
+			fmt.Println(p.Name, "cannot play or discard any more cards on the first turn.")
+			return
+		}
+
+		e = p.Game.RecordPlay(foundCard)
+
+		if e != nil {
+			fmt.Println(e)
+			return
+		}
+	}
+
 	p.HandPile = RemoveCard(p.HandPile, foundCard)
-	p.DiscardPile = AddCard(p.DiscardPile, foundCard)
 
 	fmt.Println(p.Name, "played card", foundCard.CardTitle)
 
 	if card.Amber > 0 {
 		fmt.Println(p.Name, "gains", card.Amber, "amber.")
-		p.Amber += card.Amber
+		p.GainAmber(card.Amber)
+	}
+
+	inPlay := true
+
+	switch strings.ToLower(foundCard.CardType) {
+	case "creature":
+		p.Creatures = p.deployCreature(foundCard)
+	case "artifact":
+		foundCard.IsExhausted = true
+		foundCard.Controller = p
+		p.Artifacts = AddCard(p.Artifacts, foundCard)
+	default:
+		inPlay = false
 	}
 
+	if p.Game != nil {
+		p.Game.Trigger(p, foundCard, TriggerPlay)
+	}
+
+	if !inPlay {
+		owner := ownerOf(foundCard, p)
+		owner.DiscardPile = AddCard(owner.DiscardPile, foundCard)
+	}
 }
 
 // ForgeKey - Attempt to forge a key given enough aember.
@@ -155,6 +264,7 @@ func (p *Player) ForgeKey() bool {
 		fmt.Println(p.Name, "forges a key!")
 		p.Keys++
 		p.Amber -= 6
+		p.emit(Event{Type: EventKeyForged, Player: p, Amount: 6})
 		return true
 	}
 
@@ -177,10 +287,23 @@ func (p *Player) CalculateChainHandicap() int {
 	return chains
 }
 
+// ReadyCards - Readies every creature and artifact the player has in play.
+// Stunned creatures remain stunned.
+func (p *Player) ReadyCards() {
+	for i := range p.Creatures {
+		p.Creatures[i].Ready()
+	}
+
+	for i := range p.Artifacts {
+		p.Artifacts[i].Ready()
+	}
+}
+
 // DeployCreatureLeftFlank - This function places a creature card on the left
 // flank of the battlefield
 func (p *Player) DeployCreatureLeftFlank(card Card) []Card {
 	card.IsExhausted = true
+	card.Controller = p
 	creatures := PrependCard(p.Creatures, card)
 	return creatures
 }
@@ -189,6 +312,7 @@ func (p *Player) DeployCreatureLeftFlank(card Card) []Card {
 // flank of the battlefield
 func (p *Player) DeployCreatureRightFlank(card Card) []Card {
 	card.IsExhausted = true
+	card.Controller = p
 	creatures := AddCard(p.Creatures, card)
 	return creatures
 }
@@ -199,6 +323,21 @@ func (p *Player) DeployCreatureRightFlank(card Card) []Card {
 // of an empty creature pile could be a bit confusing.
 func (p *Player) DeployCreature(card Card) []Card {
 	card.IsExhausted = true
+	card.Controller = p
 	creatures := AddCard(p.Creatures, card)
 	return creatures
 }
+
+// deployCreature - Deploys a creature on the flank chosen by the player's
+// decision provider.
+func (p *Player) deployCreature(card Card) []Card {
+	if len(p.Creatures) == 0 {
+		return p.DeployCreature(card)
+	}
+
+	if p.decisions().ChooseFlank(p, card) == LeftFlank {
+		return p.DeployCreatureLeftFlank(card)
+	}
+
+	return p.DeployCreatureRightFlank(card)
+}
@@ -0,0 +1,158 @@
+package keyforge
+
+import "strings"
+
+// Side - Identifies whose cards an effect may target, relative to the
+// player resolving the effect.
+type Side int
+
+// Sides available to target constraints.
+const (
+	SideAny Side = iota
+	SideFriendly
+	SideEnemy
+)
+
+// TargetConstraint - Describes the cards in play an effect may target.
+// Zero values place no restriction, so the zero constraint matches any
+// creature or artifact in play. Power limits are inclusive and compare
+// against each card's current power. Harmful marks effects that damage or
+// remove their target, and does not restrict what may be targeted.
+type TargetConstraint struct {
+	Side         Side
+	CardType     string
+	House        string
+	Damaged      bool
+	Undamaged    bool
+	OnFlank      bool
+	NotOnFlank   bool
+	MinimumPower int
+	MaximumPower int
+	Harmful      bool
+}
+
+// Creature - Returns a constraint matching creatures on the given side.
+func Creature(side Side) TargetConstraint {
+	return TargetConstraint{Side: side, CardType: "creature"}
+}
+
+// Artifact - Returns a constraint matching artifacts on the given side.
+func Artifact(side Side) TargetConstraint {
+	return TargetConstraint{Side: side, CardType: "artifact"}
+}
+
+// IsOnFlank - Determine whether a creature is on a flank of its
+// controller's battleline.
+func IsOnFlank(card Card) bool {
+	if card.Controller == nil {
+		return false
+	}
+
+	creatures := card.Controller.Creatures
+
+	for i, creature := range creatures {
+		if creature.IsSameCard(card) {
+			return i == 0 || i == len(creatures)-1
+		}
+	}
+
+	return false
+}
+
+// Allows - Determine whether a card in play under the given controller
+// satisfies the constraint for an effect resolved by player p.
+func (t TargetConstraint) Allows(g *Game, p *Player, controller *Player, card Card) bool {
+	if t.Side == SideFriendly && controller != p {
+		return false
+	}
+
+	if t.Side == SideEnemy && controller == p {
+		return false
+	}
+
+	if len(t.CardType) > 0 && !strings.EqualFold(card.CardType, t.CardType) {
+		return false
+	}
+
+	if len(t.House) > 0 && !strings.EqualFold(card.House, t.House) {
+		return false
+	}
+
+	if t.Damaged && card.Damage == 0 {
+		return false
+	}
+
+	if t.Undamaged && card.Damage > 0 {
+		return false
+	}
+
+	if t.OnFlank && !IsOnFlank(card) {
+		return false
+	}
+
+	if t.NotOnFlank && IsOnFlank(card) {
+		return false
+	}
+
+	if t.MinimumPower > 0 && g.Power(card) < t.MinimumPower {
+		return false
+	}
+
+	if t.MaximumPower > 0 && g.Power(card) > t.MaximumPower {
+		return false
+	}
+
+	return true
+}
+
+// ValidTargets - Returns every card in play satisfying a constraint for an
+// effect resolved by player p. Friendly cards are listed before enemy cards,
+// unless the constraint is harmful, in which case enemy cards come first.
+func (g *Game) ValidTargets(p *Player, constraint TargetConstraint) []Card {
+	targets := []Card{}
+	players := []*Player{p}
+
+	if opponent := g.Opponent(p); opponent != nil {
+		players = append(players, opponent)
+
+		if constraint.Harmful {
+			players = []*Player{opponent, p}
+		}
+	}
+
+	for _, controller := range players {
+		inPlay := append([]Card{}, controller.Creatures...)
+		inPlay = append(inPlay, controller.Artifacts...)
+
+		for _, card := range inPlay {
+			if constraint.Allows(g, p, controller, card) {
+				targets = append(targets, card)
+			}
+		}
+	}
+
+	return targets
+}
+
+// ChooseTarget - Asks player p to choose a target satisfying a constraint.
+// Returns false if there is no valid target. Targets are mandatory, so if
+// the player passes or picks an invalid card the first valid target is used.
+func (g *Game) ChooseTarget(p *Player, prompt string, constraint TargetConstraint) (Card, bool) {
+	targets := g.ValidTargets(p, constraint)
+
+	if len(targets) == 0 {
+		return Card{}, false
+	}
+
+	choice, ok := p.decisions().ChooseTarget(p, prompt, targets)
+
+	if ok {
+		for _, target := range targets {
+			if target.IsSameCard(choice) {
+				return target, true
+			}
+		}
+	}
+
+	return targets[0], true
+}
@@ -0,0 +1,122 @@
+package keyforge
+
+// PendingTrigger - A triggered ability waiting to resolve. Player is the
+// player resolving the ability, normally the controller of Card.
+type PendingTrigger struct {
+	Player  *Player
+	Card    Card
+	Trigger Trigger
+}
+
+// openWindow - Opens a trigger window. Abilities triggered while a window is
+// open are held until the outermost window closes so that they can be
+// resolved together.
+func (g *Game) openWindow() {
+	g.windowDepth++
+}
+
+// closeWindow - Closes a trigger window, resolving every held trigger once
+// the outermost window closes.
+func (g *Game) closeWindow() {
+	g.windowDepth--
+
+	if g.windowDepth > 0 {
+		return
+	}
+
+	pending := g.pendingTriggers
+	g.pendingTriggers = nil
+	g.ResolveTriggers(pending)
+}
+
+// queueTrigger - Holds a card's abilities for the given trigger until the
+// current trigger window closes. Cards without such abilities are ignored.
+func (g *Game) queueTrigger(p *Player, card Card, trigger Trigger) {
+	if len(Abilities(card, trigger)) == 0 {
+		return
+	}
+
+	g.pendingTriggers = append(g.pendingTriggers, PendingTrigger{Player: p, Card: card, Trigger: trigger})
+}
+
+// Trigger - Resolves a single card's abilities for the given trigger, or
+// holds them if a trigger window is already open.
+func (g *Game) Trigger(p *Player, card Card, trigger Trigger) {
+	g.openWindow()
+	g.queueTrigger(p, card, trigger)
+	g.closeWindow()
+}
+
+// ResolveTriggers - Resolves triggers that fired at the same time. The
+// active player's triggers resolve first, in the order that player chooses,
+// followed by every other player's triggers in turn order and then the
+// order they fired in.
+func (g *Game) ResolveTriggers(pending []PendingTrigger) {
+	players := g.PlayersInTurnOrder()
+
+	if g.ActivePlayer != nil {
+		players = append([]*Player{g.ActivePlayer}, withoutPlayer(players, g.ActivePlayer)...)
+	}
+
+	for _, player := range players {
+		own := []PendingTrigger{}
+
+		for _, trigger := range pending {
+			if trigger.Player == player {
+				own = append(own, trigger)
+			}
+		}
+
+		if player == g.ActivePlayer {
+			own = g.orderTriggers(player, own)
+		}
+
+		for _, trigger := range own {
+			g.ResolveAbilities(trigger.Player, trigger.Card, trigger.Trigger)
+		}
+	}
+}
+
+// orderTriggers - Asks a player to order their own simultaneous triggers.
+// Passing or choosing a card that is not pending resolves the earliest
+// remaining trigger next.
+func (g *Game) orderTriggers(p *Player, pending []PendingTrigger) []PendingTrigger {
+	ordered := []PendingTrigger{}
+
+	for len(pending) > 1 {
+		cards := []Card{}
+
+		for _, trigger := range pending {
+			cards = append(cards, trigger.Card)
+		}
+
+		next := 0
+
+		if choice, ok := p.decisions().ChooseTrigger(p, cards); ok {
+			for i, card := range cards {
+				if card.IsSameCard(choice) {
+					next = i
+					break
+				}
+			}
+		}
+
+		ordered = append(ordered, pending[next])
+		pending = append(pending[:next:next], pending[next+1:]...)
+	}
+
+	return append(ordered, pending...)
+}
+
+// withoutPlayer - Returns the given players with one player left out.
+func withoutPlayer(players []*Player, skip *Player) []*Player {
+	remaining := []*Player{}
+
+	for _, player := range players {
+		if player != skip {
+			remaining = append(remaining, player)
+		}
+	}
+
+	return remaining
+}
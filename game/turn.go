@@ -0,0 +1,132 @@
+package keyforge
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FirstTurnCardLimit - The number of cards the first player may play or
+// discard during the first turn of the game.
+const FirstTurnCardLimit = 1
+
+// RuleOfSix - The maximum number of times cards sharing a title may be
+// played and/or used by a player during a single turn.
+const RuleOfSix = 6
+
+// TurnState - Per-turn bookkeeping for the turn currently being played.
+// Plays and uses are counted per card title in order to enforce the Rule of
+// Six, and once per turn abilities are flagged once they have been used.
+// The total number of cards played or discarded is kept for the first-turn
+// rule. Everything in this type is cleared at the end of each turn.
+type TurnState struct {
+	Plays             map[string]int
+	Uses              map[string]int
+	OncePerTurn       map[string]bool
+	PlayedOrDiscarded int
+}
+
+// NewTurnState - Returns a pointer to an empty turn state.
+func NewTurnState() *TurnState {
+	state := new(TurnState)
+	state.Plays = map[string]int{}
+	state.Uses = map[string]int{}
+	state.OncePerTurn = map[string]bool{}
+
+	return state
+}
+
+// PlaysAndUses - Returns the number of times cards with the given title have
+// been played or used this turn.
+func (t *TurnState) PlaysAndUses(title string) int {
+	return t.Plays[title] + t.Uses[title]
+}
+
+// turnState - Returns the game's turn state, creating it if necessary.
+func (g *Game) turnState() *TurnState {
+	if g.TurnState == nil {
+		g.TurnState = NewTurnState()
+	}
+
+	return g.TurnState
+}
+
+// CanPlayOrUse - Determine whether a card may be played or used without
+// breaking the Rule of Six.
+func (g *Game) CanPlayOrUse(card Card) bool {
+	return g.turnState().PlaysAndUses(card.CardTitle) < RuleOfSix
+}
+
+// RecordPlay - Record that a card was played this turn. An error is returned
+// without recording anything if the play would break the Rule of Six.
+func (g *Game) RecordPlay(card Card) error {
+	if !g.CanPlayOrUse(card) {
+		return ruleOfSixError(card)
+	}
+
+	g.turnState().Plays[card.CardTitle]++
+	g.turnState().PlayedOrDiscarded++
+	return nil
+}
+
+// RecordUse - Record that a card was used this turn. An error is returned
+// without recording anything if the use would break the Rule of Six.
+func (g *Game) RecordUse(card Card) error {
+	if !g.CanPlayOrUse(card) {
+		return ruleOfSixError(card)
+	}
+
+	g.turnState().Uses[card.CardTitle]++
+	return nil
+}
+
+// RecordDiscard - Record that a card was discarded from hand this turn.
+func (g *Game) RecordDiscard(card Card) {
+	g.turnState().PlayedOrDiscarded++
+}
+
+// IsFirstTurn - Returns true during the first turn of the game.
+func (g *Game) IsFirstTurn() bool {
+	return g.Turn == 1
+}
+
+// CanPlayOrDiscard - Determine whether a player may play or discard another
+// card this turn. On the first turn of the game the first player may only
+// play or discard a single card; every other turn is unrestricted.
+func (g *Game) CanPlayOrDiscard(p *Player) bool {
+	if !g.IsFirstTurn() || !g.IsFirstPlayer(p) {
+		return true
+	}
+
+	return g.turnState().PlayedOrDiscarded < FirstTurnCardLimit
+}
+
+// UseOncePerTurn - Marks a card's ability as used for this turn. Returns
+// false if the ability has already been used this turn. Abilities
+// registered with RegisterOncePerTurnAbility and the Elusive keyword are
+// limited this way, and card implementations may use it for other "first
+// time each turn" rules.
+func (g *Game) UseOncePerTurn(card Card, ability string) bool {
+	key := fmt.Sprintf("%d:%s:%s", card.InstanceID, card.ID, ability)
+	state := g.turnState()
+
+	if state.OncePerTurn[key] {
+		return false
+	}
+
+	state.OncePerTurn[key] = true
+	return true
+}
+
+// EndTurn - Clears all per-turn bookkeeping, expires lasting effects that
+// end with this turn, refreshes armor and advances the turn counter.
+func (g *Game) EndTurn() {
+	g.TurnState = NewTurnState()
+	g.expireLastingEffects()
+	g.refreshArmor()
+	g.Turn++
+}
+
+func ruleOfSixError(card Card) error {
+	errorMessage := fmt.Sprintf("%s has already been played or used %d times this turn", card.CardTitle, RuleOfSix)
+	return errors.New(errorMessage)
+}
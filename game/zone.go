@@ -0,0 +1,278 @@
+package keyforge
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Zone - Identifies an area of the game a card can occupy.
+type Zone int
+
+// Zones a card instance can occupy. Hand, draw pile, discard, archives and
+// purge piles are kept by a player while creatures and artifacts are in play
+// under a player's control.
+const (
+	ZoneNone Zone = iota
+	ZoneHand
+	ZoneDrawPile
+	ZoneDiscard
+	ZoneArchives
+	ZonePurged
+	ZoneCreatures
+	ZoneArtifacts
+)
+
+// Flank - Identifies which end of a player's battleline a creature is
+// deployed to.
+type Flank int
+
+// Flanks available when deploying a creature.
+const (
+	LeftFlank Flank = iota
+	RightFlank
+)
+
+// String - Returns a human readable zone name.
+func (z Zone) String() string {
+	switch z {
+	case ZoneHand:
+		return "hand"
+	case ZoneDrawPile:
+		return "draw pile"
+	case ZoneDiscard:
+		return "discard pile"
+	case ZoneArchives:
+		return "archives"
+	case ZonePurged:
+		return "purged"
+	case ZoneCreatures:
+		return "creatures"
+	case ZoneArtifacts:
+		return "artifacts"
+	}
+
+	return "none"
+}
+
+// InPlay - Returns true for the zones that make up a player's side of the
+// board.
+func (z Zone) InPlay() bool {
+	return z == ZoneCreatures || z == ZoneArtifacts
+}
+
+// Pile - Returns a pointer to the card pile backing a zone so that callers
+// can modify it in place. Returns nil for ZoneNone.
+func (p *Player) Pile(zone Zone) *[]Card {
+	switch zone {
+	case ZoneHand:
+		return &p.HandPile
+	case ZoneDrawPile:
+		return &p.DrawPile
+	case ZoneDiscard:
+		return &p.DiscardPile
+	case ZoneArchives:
+		return &p.ArchivePile
+	case ZonePurged:
+		return &p.PurgePile
+	case ZoneCreatures:
+		return &p.Creatures
+	case ZoneArtifacts:
+		return &p.Artifacts
+	}
+
+	return nil
+}
+
+// FindCardZone - Returns the zone of this player holding the given card
+// instance, or ZoneNone if the player does not hold it.
+func (p *Player) FindCardZone(card Card) Zone {
+	zones := []Zone{ZoneCreatures, ZoneArtifacts, ZoneHand, ZoneArchives,
+		ZoneDiscard, ZoneDrawPile, ZonePurged}
+
+	for _, zone := range zones {
+		for _, pileCard := range *p.Pile(zone) {
+			if pileCard.IsSameCard(card) {
+				return zone
+			}
+		}
+	}
+
+	return ZoneNone
+}
+
+// LocateCard - Find the player and zone currently holding a card instance.
+// The card's controller and owner are searched first, followed by every
+// other player in the owner's game.
+func LocateCard(card Card) (*Player, Zone, error) {
+	candidates := []*Player{card.Controller, card.Owner}
+
+	if card.Owner != nil && card.Owner.Game != nil {
+		candidates = append(candidates, card.Owner.Game.Players...)
+	}
+
+	for _, player := range candidates {
+		if player == nil {
+			continue
+		}
+
+		if zone := player.FindCardZone(card); zone != ZoneNone {
+			return player, zone, nil
+		}
+	}
+
+	errorMessage := fmt.Sprintf("card %s is not in any zone", card.CardTitle)
+	return nil, ZoneNone, errors.New(errorMessage)
+}
+
+// takeCard - Removes a card instance from whichever zone currently holds it
+// and returns the stored copy along with the player and zone it was taken
+// from. Cards leaving play have their in-play state cleared.
+func takeCard(card Card) (Card, *Player, Zone, error) {
+	holder, zone, e := LocateCard(card)
+
+	if e != nil {
+		return Card{}, nil, ZoneNone, e
+	}
+
+	pile := holder.Pile(zone)
+	card, _ = findSameCard(*pile, card)
+
+	*pile = RemoveCard(*pile, card)
+
+	if card.Owner == nil {
+		card.Owner = holder
+	}
+
+	if zone.InPlay() {
+		releaseCapturedAmber(holder, &card)
+		card.LeavePlay()
+
+		if holder.Game != nil {
+			holder.Game.removeLastingEffects(card)
+		}
+	}
+
+	return card, holder, zone, nil
+}
+
+// moveCard - Takes a card from its current zone and hands it to place to
+// be put in its new zone. "Leaves play:" abilities resolve once a card in
+// play has been moved.
+func moveCard(card Card, place func(card Card)) error {
+	card, holder, zone, e := takeCard(card)
+
+	if e != nil {
+		return e
+	}
+
+	place(card)
+
+	if zone.InPlay() && holder.Game != nil {
+		holder.Game.Trigger(holder, card, TriggerLeavesPlay)
+	}
+
+	return nil
+}
+
+// LeavePlay - Clears the state a card only carries while it is in play and
+// returns control of the card to its owner.
+func (c *Card) LeavePlay() {
+	c.IsExhausted = false
+	c.IsStunned = false
+	c.PowerBonus = 0
+	c.ArmorBonus = 0
+	c.Damage = 0
+	c.ArmorUsed = 0
+	c.Controller = c.Owner
+}
+
+// ownerOf - Returns the owner of a card, falling back to the given player
+// for cards that were never assigned one.
+func ownerOf(card Card, fallback *Player) *Player {
+	if card.Owner != nil {
+		return card.Owner
+	}
+
+	return fallback
+}
+
+// MoveToHand - Moves a card from its current zone into its owner's hand.
+func MoveToHand(card Card) error {
+	return moveCard(card, func(card Card) {
+		card.Owner.HandPile = AddCard(card.Owner.HandPile, card)
+	})
+}
+
+// MoveToDeck - Moves a card from its current zone onto the top of its
+// owner's draw pile.
+func MoveToDeck(card Card) error {
+	return moveCard(card, func(card Card) {
+		card.Owner.DrawPile = AddCard(card.Owner.DrawPile, card)
+	})
+}
+
+// MoveToDiscard - Moves a card from its current zone into its owner's
+// discard pile.
+func MoveToDiscard(card Card) error {
+	return moveCard(card, func(card Card) {
+		card.Owner.DiscardPile = AddCard(card.Owner.DiscardPile, card)
+	})
+}
+
+// TakeControl - Moves a card in play to the given player's side of the
+// board. Creatures are deployed on the requested flank of the new
+// controller's battleline.
+func TakeControl(card Card, controller *Player, flank Flank) error {
+	holder, zone, e := LocateCard(card)
+
+	if e != nil {
+		return e
+	}
+
+	if !zone.InPlay() {
+		errorMessage := fmt.Sprintf("cannot take control of %s, card is not in play", card.CardTitle)
+		return errors.New(errorMessage)
+	}
+
+	pile := holder.Pile(zone)
+	card, _ = findSameCard(*pile, card)
+
+	*pile = RemoveCard(*pile, card)
+	card.Controller = controller
+
+	if zone == ZoneArtifacts {
+		controller.Artifacts = AddCard(controller.Artifacts, card)
+		return nil
+	}
+
+	if flank == LeftFlank {
+		controller.Creatures = PrependCard(controller.Creatures, card)
+	} else {
+		controller.Creatures = AddCard(controller.Creatures, card)
+	}
+
+	return nil
+}
+
+// MoveToArchives - Moves a card from its current zone into the given
+// player's archives. Archived cards keep their owner, so cards belonging to
+// an opponent can be archived and are later returned to that opponent.
+func MoveToArchives(card Card, archiver *Player) error {
+	return moveCard(card, func(card Card) {
+		archiver.ArchivePile = AddCard(archiver.ArchivePile, card)
+	})
+}
+
+// Purge - Removes a card from its current zone and places it in its owner's
+// purge pile. Purged cards are out of the game for good and are never
+// shuffled back into a draw pile.
+func Purge(card Card) error {
+	if _, zone, _ := LocateCard(card); zone == ZonePurged {
+		errorMessage := fmt.Sprintf("card %s has already been purged", card.CardTitle)
+		return errors.New(errorMessage)
+	}
+
+	return moveCard(card, func(card Card) {
+		card.Owner.PurgePile = AddCard(card.Owner.PurgePile, card)
+	})
+}
@@ -0,0 +1,191 @@
+package tests
+
+import (
+	keyforge "keyforge/game"
+	"testing"
+)
+
+// setupActionGame - Creates a two player game using the test deck for both
+// players, with the first player about to choose a house.
+func setupActionGame(t *testing.T) (*keyforge.Game, *keyforge.Player, *keyforge.Player) {
+	game := keyforge.NewGame()
+	player := keyforge.NewPlayer()
+	opponent := keyforge.NewPlayer()
+	game.AddPlayer(player)
+	game.AddPlayer(opponent)
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	player.SetDeck(deck)
+	opponent.SetDeck(deck)
+
+	game.Running = true
+	game.FirstPlayer = player
+	game.Turn = 2
+	game.BeginTurn(player)
+
+	return game, player, opponent
+}
+
+// deployReady - Moves the first card with the given title from a player's
+// draw pile into play, ready to be used.
+func deployReady(t *testing.T, player *keyforge.Player, title string) keyforge.Card {
+	for _, card := range player.DrawPile {
+		if card.CardTitle != title {
+			continue
+		}
+
+		player.DrawPile = keyforge.RemoveCard(player.DrawPile, card)
+
+		if card.CardType == "Artifact" {
+			player.Artifacts = keyforge.AddCard(player.Artifacts, card)
+		} else {
+			player.Creatures = player.DeployCreatureRightFlank(card)
+			player.Creatures[len(player.Creatures)-1].IsExhausted = false
+		}
+
+		return card
+	}
+
+	t.Errorf("No %s found in the draw pile!", title)
+	return keyforge.Card{}
+}
+
+// moveToHand - Moves the first card with the given title from a player's
+// draw pile into their hand.
+func moveToHand(t *testing.T, player *keyforge.Player, title string) keyforge.Card {
+	for _, card := range player.DrawPile {
+		if card.CardTitle == title {
+			player.DrawPile = keyforge.RemoveCard(player.DrawPile, card)
+			player.HandPile = keyforge.AddCard(player.HandPile, card)
+			return card
+		}
+	}
+
+	t.Errorf("No %s found in the draw pile!", title)
+	return keyforge.Card{}
+}
+
+func TestActionChooseHouse(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+
+	actions := game.LegalActions()
+
+	if len(keyforge.FilterActions(actions, keyforge.ActionChooseHouse)) != 3 {
+		t.Errorf("There should be one house choice for each of the deck's 3 houses!")
+	}
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Shadows"})
+
+	if e == nil {
+		t.Error("Chose a house that is not in the deck!")
+	}
+
+	e = game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if game.Step != keyforge.StepMain || game.ActiveHouse != "Brobnar" {
+		t.Error("Choosing a house did not move the turn on to the main step!")
+	}
+}
+
+func TestActionPlayAndDiscard(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	smith := moveToHand(t, player, "Smith")
+	fear := moveToHand(t, player, "Fear")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	actions := game.LegalActions()
+
+	if len(keyforge.FilterActions(actions, keyforge.ActionPlay)) != 1 {
+		t.Error("Only the Brobnar card in hand should be playable!")
+	}
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionPlay, Player: player, Card: fear})
+
+	if e == nil {
+		t.Error("Played a card that is not of the active house!")
+	}
+
+	e = game.Apply(keyforge.Action{Type: keyforge.ActionDiscard, Player: player, Card: smith})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(player.HandPile) != 1 || len(player.DiscardPile) != 1 {
+		t.Error("Smith was not discarded!")
+	}
+}
+
+func TestActionReapAndFight(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	valdr := deployReady(t, player, "Valdr")
+	tocsin := deployReady(t, opponent, "Tocsin")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	actions := game.LegalActions()
+
+	if len(keyforge.FilterActions(actions, keyforge.ActionReap)) != 1 {
+		t.Error("Valdr should be able to reap!")
+	}
+
+	if len(keyforge.FilterActions(actions, keyforge.ActionFight)) != 1 {
+		t.Error("Valdr should be able to fight Tocsin!")
+	}
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionFight, Player: player, Card: valdr, Target: tocsin})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(opponent.Creatures) != 0 || len(opponent.DiscardPile) != 1 {
+		t.Error("Tocsin should have been destroyed!")
+	}
+
+	if player.Creatures[0].Damage != 3 {
+		t.Errorf("Valdr has %d damage! Should have 3.", player.Creatures[0].Damage)
+	}
+
+	e = game.Apply(keyforge.Action{Type: keyforge.ActionReap, Player: player, Card: valdr})
+
+	if e == nil {
+		t.Error("Reaped with an exhausted creature!")
+	}
+}
+
+func TestActionEndTurn(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Logos"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionEndTurn, Player: player})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(player.HandPile) != 6 {
+		t.Errorf("Hand contains %d cards after ending the turn! Should contain 6.", len(player.HandPile))
+	}
+
+	if game.ActivePlayer != opponent || game.Step != keyforge.StepChooseHouse {
+		t.Error("Ending the turn did not pass the turn to the opponent!")
+	}
+
+	for _, action := range game.LegalActions() {
+		if action.Player != opponent {
+			t.Error("Legal actions were offered to the player whose turn has ended!")
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package tests
+
+import (
+	keyforge "keyforge/game"
+	"testing"
+)
+
+func TestAmberGainAndLose(t *testing.T) {
+	game := keyforge.NewGame()
+	player := keyforge.NewPlayer()
+	game.AddPlayer(player)
+
+	player.GainAmber(3)
+
+	if player.Amber != 3 {
+		t.Errorf("Player has %d aember! Should have 3.", player.Amber)
+	}
+
+	lost := player.LoseAmber(5)
+
+	if lost != 3 || player.Amber != 0 {
+		t.Errorf("Player lost %d aember and has %d left! Should lose 3 and have 0.", lost, player.Amber)
+	}
+
+	if len(game.Events) != 2 {
+		t.Errorf("Game emitted %d events! Should have emitted 2.", len(game.Events))
+	}
+}
+
+func TestAmberSteal(t *testing.T) {
+	game := keyforge.NewGame()
+	player := keyforge.NewPlayer()
+	opponent := keyforge.NewPlayer()
+	game.AddPlayer(player)
+	game.AddPlayer(opponent)
+
+	opponent.Amber = 1
+
+	stolen := player.StealAmber(opponent, 2)
+
+	if stolen != 1 || player.Amber != 1 || opponent.Amber != 0 {
+		t.Errorf("Stole %d aember! Should have stolen 1.", stolen)
+	}
+
+	events := []keyforge.Event{}
+	game.Subscribe(func(event keyforge.Event) {
+		events = append(events, event)
+	})
+
+	if player.StealAmber(opponent, 2) != 0 {
+		t.Error("Stole aember from an empty pool!")
+	}
+
+	if len(events) != 0 {
+		t.Errorf("Stealing nothing emitted %d events! Should emit none.", len(events))
+	}
+}
+
+func TestAmberCapture(t *testing.T) {
+	game := keyforge.NewGame()
+	player := keyforge.NewPlayer()
+	opponent := keyforge.NewPlayer()
+	game.AddPlayer(player)
+	game.AddPlayer(opponent)
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	player.SetDeck(deck)
+
+	creature := keyforge.GetCreatureCards(player.DrawPile)[0]
+	player.DrawPile = keyforge.RemoveCard(player.DrawPile, creature)
+	player.Creatures = player.DeployCreature(creature)
+
+	opponent.Amber = 4
+
+	captured, e := player.CaptureAmber(creature, 2)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if captured != 2 || opponent.Amber != 2 || player.Creatures[0].CapturedAmber != 2 {
+		t.Errorf("Creature captured %d aember! Should have captured 2.", captured)
+	}
+
+	e = keyforge.MoveToDiscard(creature)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if opponent.Amber != 4 {
+		t.Errorf("Opponent has %d aember! Captured aember should have been returned.", opponent.Amber)
+	}
+
+	if player.DiscardPile[0].CapturedAmber != 0 {
+		t.Error("Discarded creature still carries captured aember!")
+	}
+}
@@ -0,0 +1,293 @@
+package tests
+
+import (
+	keyforge "keyforge/game"
+	"testing"
+)
+
+// deployConstructed - Puts a constructed creature into play for a player,
+// ready to be used.
+func deployConstructed(player *keyforge.Player, card keyforge.Card) keyforge.Card {
+	card.CardType = "Creature"
+	card = keyforge.NewCardInstance(card, player)
+	player.Creatures = player.DeployCreatureRightFlank(card)
+	player.Creatures[len(player.Creatures)-1].IsExhausted = false
+
+	return card
+}
+
+func TestCombatArmor(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	knight := deployConstructed(player, keyforge.Card{ID: "knight", CardTitle: "Knight", House: "Sanctum", Power: 4, Armor: 2})
+
+	game.DealDamage(knight, 1)
+	game.DealDamage(knight, 2)
+
+	if player.Creatures[0].Damage != 1 || player.Creatures[0].ArmorUsed != 2 {
+		t.Errorf("Knight has %d damage! Armor should have prevented the first 2.", player.Creatures[0].Damage)
+	}
+
+	game.EndTurn()
+
+	if player.Creatures[0].ArmorUsed != 0 {
+		t.Error("Armor was not refreshed at the end of the turn!")
+	}
+
+	game.DealDamage(knight, 2)
+
+	if player.Creatures[0].Damage != 1 {
+		t.Error("Refreshed armor should have prevented the damage!")
+	}
+}
+
+func TestCombatFightWithArmor(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	valdr := deployReady(t, player, "Valdr")
+	knight := deployConstructed(opponent, keyforge.Card{ID: "knight", CardTitle: "Knight", House: "Sanctum", Power: 6, Armor: 2})
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionFight, Player: player, Card: valdr, Target: knight})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(opponent.Creatures) != 1 || opponent.Creatures[0].Damage != 4 {
+		t.Error("Knight's armor should have prevented 2 of Valdr's damage!")
+	}
+
+	if len(player.Creatures) != 0 {
+		t.Error("Valdr should have been destroyed by the Knight!")
+	}
+}
+
+func TestCombatHazardous(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	imp := deployReady(t, player, "Ember Imp")
+	thorns := deployConstructed(opponent, keyforge.Card{ID: "thorns", CardTitle: "Thorns", House: "Untamed", Power: 1,
+		CardText: "Hazardous 3. (Before this creature is attacked, deal 3 damage to the attacking enemy.)"})
+
+	if keyforge.KeywordValue(thorns, "Hazardous") != 3 {
+		t.Error("Thorns should have Hazardous 3!")
+	}
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Dis"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionFight, Player: player, Card: imp, Target: thorns})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(player.Creatures) != 0 {
+		t.Error("Ember Imp should have been destroyed by Hazardous damage!")
+	}
+
+	if len(opponent.Creatures) != 1 || opponent.Creatures[0].Damage != 0 {
+		t.Error("Ember Imp should not have dealt fight damage after being destroyed!")
+	}
+}
+
+func TestCombatElusive(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	valdr := deployReady(t, player, "Valdr")
+	drumble := deployConstructed(opponent, keyforge.Card{ID: "drumble", CardTitle: "Drumble", House: "Dis", Power: 7,
+		CardText: "Elusive. (The first time this creature is attacked each turn, no damage is dealt.)"})
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	e := game.Fight(player, valdr, drumble)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if player.Creatures[0].Damage != 0 || opponent.Creatures[0].Damage != 0 {
+		t.Error("No damage should be dealt the first time an elusive creature is attacked!")
+	}
+
+	player.Creatures[0].IsExhausted = false
+
+	e = game.Fight(player, valdr, drumble)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(player.Creatures) != 0 || opponent.Creatures[0].Damage != 6 {
+		t.Error("Damage should be dealt the second time an elusive creature is attacked!")
+	}
+}
+
+func TestCombatKeywordValueCardText(t *testing.T) {
+	cards, e := keyforge.LoadCardsFromFile("../data/cards.json")
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	expected := []struct {
+		title   string
+		keyword string
+		value   int
+	}{
+		{"Ancient Bear", "Assault", 2},
+		{"Briar Grubbling", "Hazardous", 5},
+		{"Ember Imp", "Assault", 0},
+	}
+
+	for _, want := range expected {
+		found := false
+
+		for _, card := range cards {
+			if card.CardTitle != want.title {
+				continue
+			}
+
+			found = true
+
+			if value := keyforge.KeywordValue(card, want.keyword); value != want.value {
+				t.Errorf("%s has %s %d! Should be %d.", card.CardTitle, want.keyword, value, want.value)
+			}
+		}
+
+		if !found {
+			t.Errorf("No %s found in the card data!", want.title)
+		}
+	}
+}
+
+func TestCombatAssault(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	brute := deployConstructed(player, keyforge.Card{ID: "brute", CardTitle: "Brute", House: "Brobnar", Power: 5,
+		CardText: "Assault 3."})
+	tocsin := deployReady(t, opponent, "Tocsin")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionFight, Player: player, Card: brute, Target: tocsin})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(opponent.Creatures) != 0 {
+		t.Error("Tocsin should have been destroyed!")
+	}
+
+	if len(player.Creatures) != 1 || player.Creatures[0].Damage != 0 {
+		t.Error("Tocsin should have been destroyed by Assault before dealing fight damage!")
+	}
+}
+
+func TestCombatBotFight(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	bot := keyforge.NewBot()
+	deployReady(t, player, "Valdr")
+	deployReady(t, opponent, "Headhunter")
+	player.Creatures[0].Damage = 3
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	fights := keyforge.FilterActions(game.LegalActions(), keyforge.ActionFight)
+
+	if action := bot.ChooseAction(player, fights); action.Type == keyforge.ActionFight {
+		t.Error("The bot should not fight with a damaged Valdr that Headhunter would destroy!")
+	}
+
+	player.Creatures[0].Damage = 0
+	player.Creatures[0].ArmorBonus = 2
+	opponent.Creatures[0].Power = 6
+	opponent.Creatures[0].Damage = 1
+
+	fights = keyforge.FilterActions(game.LegalActions(), keyforge.ActionFight)
+
+	if action := bot.ChooseAction(player, fights); action.Type != keyforge.ActionFight {
+		t.Error("The bot should fight when armor keeps Valdr alive and damage lets it destroy the enemy!")
+	}
+}
+
+func TestCombatStun(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	valdr := deployReady(t, player, "Valdr")
+	deployReady(t, opponent, "Tocsin")
+
+	e := game.Stun(valdr)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if !player.Creatures[0].IsStunned {
+		t.Error("Valdr on the board was not stunned!")
+	}
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	actions := game.LegalActions()
+
+	if len(keyforge.FilterActions(actions, keyforge.ActionReap)) != 0 || len(keyforge.FilterActions(actions, keyforge.ActionFight)) != 0 {
+		t.Error("A stunned creature should not be able to reap or fight!")
+	}
+
+	e = game.Apply(keyforge.Action{Type: keyforge.ActionRemoveStun, Player: player, Card: valdr})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if player.Creatures[0].IsStunned || !player.Creatures[0].IsExhausted {
+		t.Error("Using a stunned creature should remove the stun and exhaust it!")
+	}
+
+	if player.Amber != 0 {
+		t.Error("Removing a stun should not reap!")
+	}
+}
+
+func TestCombatReapStunned(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	valdr := deployReady(t, player, "Valdr")
+	game.Stun(valdr)
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	e := game.Reap(player, valdr)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if player.Creatures[0].IsStunned || !player.Creatures[0].IsExhausted {
+		t.Error("Reaping with a stunned creature should remove the stun and exhaust it!")
+	}
+
+	if player.Amber != 0 {
+		t.Error("Reaping with a stunned creature should not gain aember!")
+	}
+}
+
+func TestCombatReadyStep(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	valdr := deployReady(t, player, "Valdr")
+	deployReady(t, player, "Headhunter")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+	game.Apply(keyforge.Action{Type: keyforge.ActionReap, Player: player, Card: valdr})
+	game.Stun(player.Creatures[1])
+
+	if !player.Creatures[0].IsExhausted {
+		t.Error("Valdr should be exhausted after reaping!")
+	}
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionEndTurn, Player: player})
+
+	if player.Creatures[0].IsExhausted {
+		t.Error("Valdr was not readied at the end of the turn!")
+	}
+
+	if !player.Creatures[1].IsStunned {
+		t.Error("Readying should not remove a stun!")
+	}
+}
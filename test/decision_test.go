@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"bytes"
+	keyforge "keyforge/game"
+	"strings"
+	"testing"
+)
+
+func TestDecisionConsoleChooseHouse(t *testing.T) {
+	output := bytes.Buffer{}
+	input := strings.NewReader("7\nabc\n2\n")
+	console := keyforge.NewConsoleDecisions(input, &output)
+	player := keyforge.NewPlayer()
+
+	house := console.ChooseHouse(player, []string{"Brobnar", "Dis", "Logos"})
+
+	if house != "Dis" {
+		t.Errorf("Chose house %s! Should have chosen Dis.", house)
+	}
+
+	if strings.Count(output.String(), "Invalid choice") != 2 {
+		t.Error("Invalid answers were not rejected!")
+	}
+}
+
+func TestDecisionConsoleChooseTarget(t *testing.T) {
+	output := bytes.Buffer{}
+	input := strings.NewReader("2\n0\n")
+	console := keyforge.NewConsoleDecisions(input, &output)
+	player := keyforge.NewPlayer()
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	card, ok := console.ChooseTarget(player, "Choose a target:", deck.Cards[:3])
+
+	if !ok || card.ID != deck.Cards[1].ID {
+		t.Errorf("Chose to target %s! Should have chosen %s.", card.CardTitle, deck.Cards[1].CardTitle)
+	}
+
+	_, ok = console.ChooseTarget(player, "Choose a target:", deck.Cards[:3])
+
+	if ok {
+		t.Error("Answering 0 should pass!")
+	}
+
+	_, ok = console.ChooseTarget(player, "Choose a target:", deck.Cards[:3])
+
+	if ok {
+		t.Error("Exhausted input should pass!")
+	}
+}
+
+func TestDecisionConsoleConfirm(t *testing.T) {
+	output := bytes.Buffer{}
+	input := strings.NewReader("maybe\ny\nn\n")
+	console := keyforge.NewConsoleDecisions(input, &output)
+	player := keyforge.NewPlayer()
+
+	if !console.ChooseMulligan(player) {
+		t.Error("Answering y should mulligan!")
+	}
+
+	if console.ChooseOptional(player, "Resolve?") {
+		t.Error("Answering n should decline!")
+	}
+
+	if console.ChooseTakeArchives(player) {
+		t.Error("Exhausted input should decline!")
+	}
+}
+
+func TestDecisionHumanAgainstBot(t *testing.T) {
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	output := bytes.Buffer{}
+	game := keyforge.NewGame()
+	game.Seed = 1
+
+	human := keyforge.NewPlayer()
+	human.Name = "Human"
+	human.SetDeck(deck)
+	human.Decisions = keyforge.NewConsoleDecisions(strings.NewReader("n\n1\n1\n"), &output)
+
+	bot := keyforge.NewBot()
+	bot.Name = "Bot"
+	bot.SetDeck(deck)
+
+	game.AddPlayer(human)
+	game.AddBot(bot)
+	game.Start()
+
+	if game.Running {
+		t.Error("Game did not finish!")
+	}
+
+	if human.Keys < 3 && bot.Keys < 3 {
+		t.Error("Game finished without a winner!")
+	}
+
+	if !strings.Contains(output.String(), "Mulligan?") {
+		t.Error("Human player was never asked to mulligan!")
+	}
+}
@@ -0,0 +1,60 @@
+package tests
+
+import (
+	keyforge "keyforge/game"
+	"testing"
+)
+
+func TestGameDealOpeningHands(t *testing.T) {
+	game := keyforge.NewGame()
+	first := keyforge.NewBot()
+	second := keyforge.NewBot()
+	game.AddBot(first)
+	game.AddBot(second)
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	first.SetDeck(deck)
+	second.SetDeck(deck)
+	game.FirstPlayer = &first.Player
+
+	game.DealOpeningHands()
+
+	expected := map[*keyforge.Player]int{&first.Player: 7, &second.Player: 6}
+	dealt := 0
+
+	for _, event := range game.Events {
+		switch event.Type {
+		case keyforge.EventHandDealt:
+			dealt++
+
+			if event.Amount != expected[event.Player] {
+				t.Errorf("%d cards dealt! Should have dealt %d.", event.Amount, expected[event.Player])
+			}
+		case keyforge.EventMulligan:
+			expected[event.Player]--
+		}
+	}
+
+	if dealt != 2 {
+		t.Errorf("%d hands were dealt! Should have dealt 2.", dealt)
+	}
+
+	if game.Events[0].Player != &first.Player {
+		t.Error("First player should be dealt their hand first!")
+	}
+
+	for _, bot := range []*keyforge.Bot{first, second} {
+		if len(bot.HandPile) != expected[&bot.Player] {
+			t.Errorf("Hand contains %d cards! Should contain %d.", len(bot.HandPile), expected[&bot.Player])
+		}
+
+		if len(bot.HandPile)+len(bot.DrawPile) != 36 {
+			t.Errorf("Cards were lost while dealing the opening hand!")
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	keyforge "keyforge/game"
+	"testing"
+)
+
+func TestLastingPowerModifier(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	valdr := deployReady(t, player, "Valdr")
+	imp := deployReady(t, opponent, "Ember Imp")
+	banner := keyforge.NewCardInstance(keyforge.Card{ID: "banner", CardTitle: "Banner of Battle", House: "Brobnar", CardType: "Artifact"}, player)
+	player.HandPile = keyforge.AddCard(player.HandPile, banner)
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionPlay, Player: player, Card: banner})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if game.Power(valdr) != 7 {
+		t.Errorf("Valdr has %d power! Should be 7.", game.Power(valdr))
+	}
+
+	if game.Power(imp) != 2 {
+		t.Error("Banner of Battle should not affect enemy creatures!")
+	}
+
+	e = game.Destroy(banner)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if game.Power(valdr) != 6 || len(game.LastingEffects) != 0 {
+		t.Error("Banner of Battle's effect did not end when it left play!")
+	}
+}
+
+func TestLastingThisTurn(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	valdr := deployReady(t, player, "Valdr")
+	imp := deployReady(t, opponent, "Ember Imp")
+
+	game.AddLastingEffect(keyforge.LastingEffect{Controller: player, Scope: keyforge.Creature(keyforge.SideFriendly),
+		Duration: keyforge.DurationThisTurn, PreventDamage: true})
+
+	game.DealDamage(valdr, 3)
+
+	if player.Creatures[0].Damage != 0 {
+		t.Error("Damage should have been prevented!")
+	}
+
+	game.DealDamage(imp, 1)
+
+	if opponent.Creatures[0].Damage != 1 {
+		t.Error("Damage to enemy creatures should not be prevented!")
+	}
+
+	game.EndTurn()
+
+	if len(game.LastingEffects) != 0 {
+		t.Error("Effect lasting for this turn did not expire at the end of the turn!")
+	}
+
+	game.DealDamage(valdr, 3)
+
+	if player.Creatures[0].Damage != 3 {
+		t.Error("Damage should no longer be prevented!")
+	}
+}
+
+func TestLastingDurations(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	valdr := deployReady(t, player, "Valdr")
+
+	game.AddLastingEffect(keyforge.LastingEffect{Controller: player, Target: valdr,
+		Duration: keyforge.DurationOpponentsNextTurn, ArmorModifier: 2})
+	game.AddLastingEffect(keyforge.LastingEffect{Controller: player, Target: valdr,
+		Duration: keyforge.DurationYourNextTurn, PowerModifier: 3})
+
+	if game.Armor(valdr) != 2 || game.Power(valdr) != 6 {
+		t.Error("Only the armor effect should be active this turn!")
+	}
+
+	game.EndTurn()
+
+	if game.Armor(valdr) != 2 || game.Power(valdr) != 6 {
+		t.Error("Only the armor effect should be active during the opponent's turn!")
+	}
+
+	game.EndTurn()
+
+	if game.Armor(valdr) != 0 || game.Power(valdr) != 9 {
+		t.Error("Only the power effect should be active during the player's next turn!")
+	}
+
+	game.EndTurn()
+
+	if len(game.LastingEffects) != 0 {
+		t.Errorf("%d lasting effects remain! Should be 0.", len(game.LastingEffects))
+	}
+}
@@ -38,6 +38,36 @@ func TestPlayerDrawCard(t *testing.T) {
 	}
 }
 
+func TestPlayerDrawCardReshuffle(t *testing.T) {
+	player := keyforge.NewPlayer()
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	player.SetDeck(deck)
+	player.DrawCards(len(deck.Cards) - 2)
+	player.DiscardPile = append(player.DiscardPile, player.HandPile...)
+	player.HandPile = nil
+	player.DrawCards(len(deck.Cards))
+
+	seen := map[int]bool{}
+
+	for _, card := range append(player.HandPile, player.DrawPile...) {
+		if seen[card.InstanceID] {
+			t.Fatalf("%s was drawn twice!", card.CardTitle)
+		}
+
+		seen[card.InstanceID] = true
+	}
+
+	if len(seen) != len(deck.Cards) {
+		t.Errorf("Player holds %d cards! Should hold %d.", len(seen), len(deck.Cards))
+	}
+}
+
 func TestPlayerDrawHand(t *testing.T) {
 	player := keyforge.NewPlayer()
 
@@ -214,3 +244,106 @@ func TestPlayerDeployCreatureRightFlank(t *testing.T) {
 		t.Errorf("Incorrect card placed at the left flank! Should be %s", creatures[0].CardTitle)
 	}
 }
+
+func TestPlayerArchive(t *testing.T) {
+	player := keyforge.NewPlayer()
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	player.SetDeck(deck)
+	player.DrawHand()
+
+	card := player.HandPile[0]
+	e = player.Archive(card)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(player.HandPile) != 5 {
+		t.Errorf("Hand contains %d cards! Should contain 5.", len(player.HandPile))
+	}
+
+	if len(player.ArchivePile) != 1 || player.ArchivePile[0].InstanceID != card.InstanceID {
+		t.Errorf("%s was not archived!", card.CardTitle)
+	}
+
+	e = player.Archive(card)
+
+	if e == nil {
+		t.Error("Archived a card that is no longer in hand!")
+	}
+}
+
+func TestPlayerTakeArchives(t *testing.T) {
+	game := keyforge.NewGame()
+	player := keyforge.NewPlayer()
+	opponent := keyforge.NewPlayer()
+	game.AddPlayer(player)
+	game.AddPlayer(opponent)
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	player.SetDeck(deck)
+	opponent.SetDeck(deck)
+
+	e = keyforge.MoveToArchives(player.DrawPile[0], player)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	e = keyforge.MoveToArchives(opponent.DrawPile[0], player)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(player.ArchivePile) != 2 {
+		t.Errorf("Archives contain %d cards! Should contain 2.", len(player.ArchivePile))
+	}
+
+	player.TakeArchives()
+
+	if len(player.ArchivePile) != 0 {
+		t.Errorf("Archives contain %d cards! Should be empty.", len(player.ArchivePile))
+	}
+
+	if len(player.HandPile) != 1 {
+		t.Errorf("Hand contains %d cards! Should contain 1.", len(player.HandPile))
+	}
+
+	if len(opponent.HandPile) != 1 {
+		t.Errorf("Opponent's card was not returned to their hand!")
+	}
+}
+
+func TestPlayerMulligan(t *testing.T) {
+	player := keyforge.NewPlayer()
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	player.SetDeck(deck)
+	player.DrawCards(7)
+	player.Mulligan()
+
+	if len(player.HandPile) != 6 {
+		t.Errorf("Hand contains %d cards after a mulligan! Should contain 6.", len(player.HandPile))
+	}
+
+	if len(player.DrawPile) != 30 {
+		t.Errorf("Draw pile contains %d cards after a mulligan! Should contain 30.", len(player.DrawPile))
+	}
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	keyforge "keyforge/game"
+	"testing"
+)
+
+func TestTargetValidTargets(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	deployReady(t, player, "Valdr")
+	deployReady(t, player, "Headhunter")
+	deployReady(t, player, "Tocsin")
+	deployReady(t, opponent, "Ember Imp")
+	deployReady(t, opponent, "Key to Dis")
+
+	friendly := game.ValidTargets(player, keyforge.Creature(keyforge.SideFriendly))
+
+	if len(friendly) != 3 {
+		t.Errorf("Found %d friendly creatures! Should be 3.", len(friendly))
+	}
+
+	enemy := game.ValidTargets(player, keyforge.Artifact(keyforge.SideEnemy))
+
+	if len(enemy) != 1 || enemy[0].CardTitle != "Key to Dis" {
+		t.Error("Key to Dis should be the only enemy artifact!")
+	}
+
+	middle := game.ValidTargets(player, keyforge.TargetConstraint{CardType: "creature", NotOnFlank: true})
+
+	if len(middle) != 1 || middle[0].CardTitle != "Headhunter" {
+		t.Error("Headhunter should be the only creature not on a flank!")
+	}
+
+	powerful := game.ValidTargets(player, keyforge.TargetConstraint{CardType: "creature", MinimumPower: 5, House: "Brobnar"})
+
+	if len(powerful) != 2 {
+		t.Errorf("Found %d Brobnar creatures with power 5 or higher! Should be 2.", len(powerful))
+	}
+
+	damaged := game.ValidTargets(player, keyforge.TargetConstraint{Damaged: true})
+
+	if len(damaged) != 0 {
+		t.Error("No card in play has been damaged!")
+	}
+}
+
+func TestTargetPlayEffect(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	bot := keyforge.NewBot()
+	player.Decisions = bot
+
+	deployReady(t, opponent, "Ember Imp")
+	fear := moveToHand(t, player, "Fear")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Dis"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionPlay, Player: player, Card: fear})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(opponent.Creatures) != 0 || len(opponent.HandPile) != 1 {
+		t.Error("Fear did not return the enemy creature to its owner's hand!")
+	}
+
+	if len(player.DiscardPile) != 1 {
+		t.Error("Fear was not discarded after being played!")
+	}
+}
+
+func TestTargetHarmfulEnemyFirst(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	player.Decisions = keyforge.NewBot()
+
+	deployReady(t, player, "Valdr")
+	deployReady(t, opponent, "Tocsin")
+
+	punch := keyforge.NewCardInstance(keyforge.Card{ID: "punch", CardTitle: "Punch", House: "Brobnar", CardType: "Action", Amber: 1}, player)
+	player.HandPile = keyforge.AddCard(player.HandPile, punch)
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionPlay, Player: player, Card: punch})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(player.Creatures) != 1 || player.Creatures[0].Damage != 0 {
+		t.Error("The bot should not have punched its own creature!")
+	}
+
+	if len(opponent.Creatures) == 1 && opponent.Creatures[0].Damage == 0 {
+		t.Error("The bot should have punched the enemy creature!")
+	}
+}
+
+func TestTargetNoValidTarget(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	punch := keyforge.NewCardInstance(keyforge.Card{ID: "punch", CardTitle: "Punch", House: "Brobnar", CardType: "Action", Amber: 1}, player)
+	player.HandPile = keyforge.AddCard(player.HandPile, punch)
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionPlay, Player: player, Card: punch})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if player.Amber != 1 {
+		t.Error("Punch should still give its aember bonus without a target!")
+	}
+}
+
+func TestTargetAnger(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	player.Decisions = keyforge.NewBot()
+
+	deployReady(t, player, "Valdr")
+	deployReady(t, opponent, "Tocsin")
+	player.Creatures[0].IsExhausted = true
+
+	anger := keyforge.NewCardInstance(keyforge.Card{ID: "anger", CardTitle: "Anger", House: "Brobnar", CardType: "Action", Amber: 1}, player)
+	player.HandPile = keyforge.AddCard(player.HandPile, anger)
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionPlay, Player: player, Card: anger})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(opponent.Creatures) != 0 {
+		t.Error("Valdr should have been readied to fight and destroy Tocsin!")
+	}
+
+	if !player.Creatures[0].IsExhausted {
+		t.Error("Valdr should be exhausted after fighting!")
+	}
+}
+
+func TestTargetAngerStunned(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	player.Decisions = keyforge.NewBot()
+
+	deployReady(t, player, "Valdr")
+	deployReady(t, opponent, "Tocsin")
+	player.Creatures[0].IsExhausted = true
+	game.Stun(player.Creatures[0])
+
+	anger := keyforge.NewCardInstance(keyforge.Card{ID: "anger", CardTitle: "Anger", House: "Brobnar", CardType: "Action", Amber: 1}, player)
+	player.HandPile = keyforge.AddCard(player.HandPile, anger)
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionPlay, Player: player, Card: anger})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(opponent.Creatures) != 1 {
+		t.Error("A stunned Valdr should not have fought Tocsin!")
+	}
+
+	if player.Creatures[0].IsStunned || !player.Creatures[0].IsExhausted {
+		t.Error("Anger should have removed Valdr's stun and left it exhausted!")
+	}
+}
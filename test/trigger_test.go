@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"bytes"
+	keyforge "keyforge/game"
+	"strings"
+	"testing"
+)
+
+// resolved - Titles of test cards in the order their abilities resolved.
+var resolved = []string{}
+
+func init() {
+	record := keyforge.Effect{
+		Resolve: func(g *keyforge.Game, p *keyforge.Player, source keyforge.Card, target keyforge.Card) {
+			resolved = append(resolved, source.CardTitle)
+		},
+	}
+
+	keyforge.RegisterAbility("Trigger Test A", keyforge.TriggerDestroyed, record)
+	keyforge.RegisterAbility("Trigger Test B", keyforge.TriggerDestroyed, record)
+	keyforge.RegisterAbility("Trigger Test C", keyforge.TriggerDestroyed, record)
+
+	keyforge.RegisterAbility("Trigger Test Leaves", keyforge.TriggerLeavesPlay, keyforge.Effect{
+		Resolve: func(g *keyforge.Game, p *keyforge.Player, source keyforge.Card, target keyforge.Card) {
+			_, zone, _ := keyforge.LocateCard(source)
+			resolved = append(resolved, zone.String())
+		},
+	})
+
+	keyforge.RegisterAbility("Trigger Test Fighter", keyforge.TriggerBeforeFight, keyforge.Effect{
+		Resolve: func(g *keyforge.Game, p *keyforge.Player, source keyforge.Card, target keyforge.Card) {
+			for _, creature := range g.CreaturesInPlay() {
+				if creature.Damage > 0 {
+					return
+				}
+			}
+
+			resolved = append(resolved, source.CardTitle)
+		},
+	})
+}
+
+func TestTriggerReap(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	bookton := deployReady(t, player, "Doc Bookton")
+	hand := len(player.HandPile)
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Logos"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionReap, Player: player, Card: bookton})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(player.HandPile) != hand+1 {
+		t.Error("Doc Bookton did not draw a card after reaping!")
+	}
+}
+
+func TestTriggerFight(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	headhunter := deployReady(t, player, "Headhunter")
+	imp := deployReady(t, opponent, "Ember Imp")
+	valdr := deployReady(t, opponent, "Valdr")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+	game.Apply(keyforge.Action{Type: keyforge.ActionFight, Player: player, Card: headhunter, Target: imp})
+
+	if player.Amber != 1 {
+		t.Error("Headhunter did not gain aember after surviving a fight!")
+	}
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionEndTurn, Player: player})
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: opponent, House: "Logos"})
+	game.Apply(keyforge.Action{Type: keyforge.ActionEndTurn, Player: opponent})
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+	game.Apply(keyforge.Action{Type: keyforge.ActionFight, Player: player, Card: headhunter, Target: valdr})
+
+	if len(player.Creatures) != 0 || player.Amber != 1 {
+		t.Error("Headhunter should not gain aember after being destroyed in a fight!")
+	}
+}
+
+func TestTriggerBeforeFight(t *testing.T) {
+	resolved = []string{}
+	game, player, opponent := setupActionGame(t)
+	fighter := deployConstructed(player, keyforge.Card{ID: "fighter", CardTitle: "Trigger Test Fighter", House: "Brobnar", Power: 4})
+	tocsin := deployReady(t, opponent, "Tocsin")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+	game.Apply(keyforge.Action{Type: keyforge.ActionFight, Player: player, Card: fighter, Target: tocsin})
+
+	if len(resolved) != 1 {
+		t.Error("Before fight ability should resolve before any damage is dealt!")
+	}
+}
+
+func TestTriggerDestroyedBeforeLeavingPlay(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	dextre := deployReady(t, player, "Dextre")
+	smoko := deployReady(t, player, "Research Smoko")
+	archives := len(player.ArchivePile)
+
+	game.DestroyAll([]keyforge.Card{smoko, dextre})
+
+	top := player.DrawPile[len(player.DrawPile)-1]
+
+	if !top.IsSameCard(dextre) {
+		t.Error("Dextre was not put on top of its owner's deck!")
+	}
+
+	if len(player.ArchivePile) != archives+1 {
+		t.Error("Research Smoko did not archive a card!")
+	}
+
+	if player.FindCardZone(smoko) != keyforge.ZoneDiscard {
+		t.Error("Research Smoko was not discarded!")
+	}
+}
+
+func TestTriggerLeavesPlay(t *testing.T) {
+	resolved = []string{}
+	game, player, _ := setupActionGame(t)
+	leaves := deployConstructed(player, keyforge.Card{ID: "leaves", CardTitle: "Trigger Test Leaves", House: "Logos", Power: 2})
+
+	game.Destroy(leaves)
+
+	if len(resolved) != 1 || resolved[0] != keyforge.ZoneDiscard.String() {
+		t.Errorf("Leaves play ability should resolve once the card has left play! Resolved: %v", resolved)
+	}
+}
+
+func TestTriggerOrdering(t *testing.T) {
+	resolved = []string{}
+	game, player, opponent := setupActionGame(t)
+	player.Decisions = keyforge.NewConsoleDecisions(strings.NewReader("2\n"), &bytes.Buffer{})
+
+	c := deployConstructed(opponent, keyforge.Card{ID: "c", CardTitle: "Trigger Test C", House: "Logos", Power: 1})
+	a := deployConstructed(player, keyforge.Card{ID: "a", CardTitle: "Trigger Test A", House: "Logos", Power: 1})
+	b := deployConstructed(player, keyforge.Card{ID: "b", CardTitle: "Trigger Test B", House: "Logos", Power: 1})
+
+	game.DestroyAll([]keyforge.Card{c, a, b})
+
+	expected := []string{"Trigger Test B", "Trigger Test A", "Trigger Test C"}
+
+	if strings.Join(resolved, ",") != strings.Join(expected, ",") {
+		t.Errorf("Triggers resolved in the order %v! Should be %v.", resolved, expected)
+	}
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	keyforge "keyforge/game"
+	"testing"
+)
+
+func TestTurnRuleOfSix(t *testing.T) {
+	game := keyforge.NewGame()
+	card := keyforge.Card{ID: "test", CardTitle: "Test Card"}
+
+	for i := 0; i < keyforge.RuleOfSix-1; i++ {
+		e := game.RecordPlay(card)
+
+		if e != nil {
+			t.Error(e.Error())
+		}
+	}
+
+	e := game.RecordUse(card)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if game.CanPlayOrUse(card) {
+		t.Error("Card can be played a seventh time this turn!")
+	}
+
+	if game.RecordPlay(card) == nil {
+		t.Error("Recorded a play that breaks the Rule of Six!")
+	}
+
+	game.EndTurn()
+
+	if !game.CanPlayOrUse(card) {
+		t.Error("Rule of Six was not reset at the end of the turn!")
+	}
+}
+
+func TestTurnOncePerTurn(t *testing.T) {
+	game := keyforge.NewGame()
+	card := keyforge.Card{ID: "test", CardTitle: "Test Card", InstanceID: 1}
+	copyCard := keyforge.Card{ID: "test", CardTitle: "Test Card", InstanceID: 2}
+
+	if !game.UseOncePerTurn(card, "reap") {
+		t.Error("Ability could not be used the first time this turn!")
+	}
+
+	if game.UseOncePerTurn(card, "reap") {
+		t.Error("Ability was used twice in one turn!")
+	}
+
+	if !game.UseOncePerTurn(copyCard, "reap") {
+		t.Error("A second copy of the card shares the first copy's once per turn flag!")
+	}
+
+	game.EndTurn()
+
+	if !game.UseOncePerTurn(card, "reap") {
+		t.Error("Once per turn flag was not reset at the end of the turn!")
+	}
+}
+
+func TestTurnOncePerTurnAbility(t *testing.T) {
+	game := keyforge.NewGame()
+	player := keyforge.NewPlayer()
+	game.AddPlayer(player)
+
+	card := keyforge.Card{ID: "once", CardTitle: "Once Per Turn Test", InstanceID: 1}
+	resolved := 0
+
+	keyforge.RegisterOncePerTurnAbility(card.CardTitle, keyforge.TriggerReap, keyforge.Effect{
+		Resolve: func(g *keyforge.Game, p *keyforge.Player, source keyforge.Card, target keyforge.Card) {
+			resolved++
+		},
+	})
+
+	game.ResolveAbilities(player, card, keyforge.TriggerReap)
+	game.ResolveAbilities(player, card, keyforge.TriggerReap)
+
+	if resolved != 1 {
+		t.Errorf("Once per turn ability resolved %d times! Should be once.", resolved)
+	}
+
+	game.EndTurn()
+	game.ResolveAbilities(player, card, keyforge.TriggerReap)
+
+	if resolved != 2 {
+		t.Error("Once per turn ability could not be resolved again the next turn!")
+	}
+}
+
+func TestTurnFirstTurnRule(t *testing.T) {
+	game := keyforge.NewGame()
+	first := keyforge.NewPlayer()
+	second := keyforge.NewPlayer()
+	game.AddPlayer(first)
+	game.AddPlayer(second)
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	first.SetDeck(deck)
+	first.DrawHand()
+
+	game.FirstPlayer = first
+	game.Turn = 1
+
+	if !game.IsFirstTurn() {
+		t.Error("Game should be on its first turn!")
+	}
+
+	first.Discard(first.HandPile[0])
+	first.PlayCard(first.HandPile[0])
+
+	if len(first.HandPile) != 5 {
+		t.Errorf("Hand contains %d cards! Only one card may be played or discarded on the first turn.", len(first.HandPile))
+	}
+
+	if !game.CanPlayOrDiscard(second) {
+		t.Error("First-turn rule should only apply to the first player!")
+	}
+
+	game.EndTurn()
+
+	if game.IsFirstTurn() || !game.CanPlayOrDiscard(first) {
+		t.Error("First-turn rule still applies after the first turn!")
+	}
+}
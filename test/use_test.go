@@ -0,0 +1,109 @@
+package tests
+
+import (
+	keyforge "keyforge/game"
+	"testing"
+)
+
+func TestUseOmniOutsideActiveHouse(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	key := deployReady(t, player, "Key to Dis")
+	deployReady(t, player, "Valdr")
+	deployReady(t, opponent, "Tocsin")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Logos"})
+
+	use := keyforge.Action{Type: keyforge.ActionUseArtifact, Player: player, Card: key}
+
+	if !game.IsLegal(use) {
+		t.Fatal("Omni artifacts should be usable outside the active house!")
+	}
+
+	e := game.Apply(use)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(player.Artifacts) != 0 || len(player.Creatures) != 0 || len(opponent.Creatures) != 0 {
+		t.Error("Key to Dis should have been sacrificed and destroyed each creature!")
+	}
+}
+
+func TestUseOmniBot(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	bot := keyforge.NewBot()
+	key := deployReady(t, player, "Key to Dis")
+	deployReady(t, player, "Valdr")
+	deployReady(t, opponent, "Tocsin")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Logos"})
+
+	uses := keyforge.FilterActions(game.LegalActions(), keyforge.ActionUseArtifact)
+
+	if action := bot.ChooseAction(player, uses); action.Type != keyforge.ActionEndTurn {
+		t.Error("The bot should not use Key to Dis while it is not behind on board!")
+	}
+
+	deployReady(t, opponent, "Ember Imp")
+
+	if action := bot.ChooseAction(player, uses); action.Type != keyforge.ActionUseArtifact || !action.Card.IsSameCard(key) {
+		t.Error("The bot should use Key to Dis while it is behind on board!")
+	}
+}
+
+func TestUseOmniScreechbomb(t *testing.T) {
+	game, player, opponent := setupActionGame(t)
+	bomb := deployReady(t, player, "Screechbomb")
+	opponent.Amber = 3
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Dis"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionUseArtifact, Player: player, Card: bomb})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if opponent.Amber != 1 {
+		t.Errorf("Opponent has %d amber! Should be 1.", opponent.Amber)
+	}
+}
+
+func TestUseActionRequiresActiveHouse(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	bauble := deployReady(t, player, "Dominator Bauble")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Brobnar"})
+
+	use := keyforge.Action{Type: keyforge.ActionUseArtifact, Player: player, Card: bauble}
+
+	if game.IsLegal(use) {
+		t.Error("Action abilities should only be usable while their house is active!")
+	}
+
+	if game.UseCard(player, bauble) == nil {
+		t.Error("Using an action ability outside the active house should fail!")
+	}
+}
+
+func TestUseActionExhausts(t *testing.T) {
+	game, player, _ := setupActionGame(t)
+	bauble := deployReady(t, player, "Dominator Bauble")
+
+	game.Apply(keyforge.Action{Type: keyforge.ActionChooseHouse, Player: player, House: "Dis"})
+
+	e := game.Apply(keyforge.Action{Type: keyforge.ActionUseArtifact, Player: player, Card: bauble})
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if !player.Artifacts[0].IsExhausted {
+		t.Error("Using an artifact should exhaust it!")
+	}
+
+	if game.IsLegal(keyforge.Action{Type: keyforge.ActionUseArtifact, Player: player, Card: bauble}) {
+		t.Error("An exhausted artifact should not be usable!")
+	}
+}
@@ -0,0 +1,162 @@
+package tests
+
+import (
+	keyforge "keyforge/game"
+	"testing"
+)
+
+func TestZoneSetDeckOwnership(t *testing.T) {
+	player := keyforge.NewPlayer()
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	player.SetDeck(deck)
+
+	for _, card := range player.DrawPile {
+		if card.Owner != player || card.Controller != player {
+			t.Errorf("%s is not owned and controlled by the player who set the deck!", card.CardTitle)
+		}
+
+		if card.InstanceID == 0 {
+			t.Errorf("%s was not assigned an instance ID!", card.CardTitle)
+		}
+	}
+}
+
+func TestZoneTakeControlReturnsToOwner(t *testing.T) {
+	game := keyforge.NewGame()
+	owner := keyforge.NewPlayer()
+	thief := keyforge.NewPlayer()
+	game.AddPlayer(owner)
+	game.AddPlayer(thief)
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	owner.SetDeck(deck)
+
+	creatures := keyforge.GetCreatureCards(owner.DrawPile)
+	owner.DrawPile = keyforge.RemoveCard(owner.DrawPile, creatures[0])
+	owner.Creatures = owner.DeployCreature(creatures[0])
+
+	e = keyforge.TakeControl(creatures[0], thief, keyforge.RightFlank)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(owner.Creatures) != 0 || len(thief.Creatures) != 1 {
+		t.Errorf("Creature was not moved to the thief's battleline!")
+	}
+
+	if thief.Creatures[0].Controller != thief || thief.Creatures[0].Owner != owner {
+		t.Errorf("Stolen creature has the wrong owner or controller!")
+	}
+
+	e = keyforge.MoveToHand(thief.Creatures[0])
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(thief.Creatures) != 0 || len(thief.HandPile) != 0 {
+		t.Errorf("Stolen creature should have left the thief's side of the game!")
+	}
+
+	if len(owner.HandPile) != 1 || owner.HandPile[0].Controller != owner {
+		t.Errorf("Creature was not returned to its owner's hand!")
+	}
+}
+
+func TestZoneMoveToDiscard(t *testing.T) {
+	game := keyforge.NewGame()
+	owner := keyforge.NewPlayer()
+	other := keyforge.NewPlayer()
+	game.AddPlayer(owner)
+	game.AddPlayer(other)
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	owner.SetDeck(deck)
+	card := owner.DrawPile[0]
+	owner.DrawPile = keyforge.RemoveCard(owner.DrawPile, card)
+	other.ArchivePile = keyforge.AddCard(other.ArchivePile, card)
+
+	e = keyforge.MoveToDiscard(card)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(other.ArchivePile) != 0 || len(owner.DiscardPile) != 1 {
+		t.Errorf("Card was not moved into its owner's discard pile!")
+	}
+}
+
+func TestZonePurge(t *testing.T) {
+	game := keyforge.NewGame()
+	owner := keyforge.NewPlayer()
+	other := keyforge.NewPlayer()
+	game.AddPlayer(owner)
+	game.AddPlayer(other)
+
+	deck, e := keyforge.LoadDeckFromFile("test_data/test_deck.json")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	owner.SetDeck(deck)
+	other.SetDeck(deck)
+
+	creatures := keyforge.GetCreatureCards(owner.DrawPile)
+	owner.DrawPile = keyforge.RemoveCard(owner.DrawPile, creatures[0])
+	owner.Creatures = owner.DeployCreature(creatures[0])
+
+	e = keyforge.TakeControl(creatures[0], other, keyforge.LeftFlank)
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	e = keyforge.Purge(creatures[0])
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if len(other.Creatures) != 0 {
+		t.Errorf("Purged creature is still in play!")
+	}
+
+	if len(owner.PurgePile) != 1 || len(other.PurgePile) != 0 {
+		t.Errorf("Purged creature was not placed in its owner's purge pile!")
+	}
+
+	if len(game.PurgedCards()) != 1 {
+		t.Errorf("Game reports %d purged cards! Should be 1.", len(game.PurgedCards()))
+	}
+
+	byPlayer := game.PurgedCardsByPlayer()
+
+	if len(byPlayer[owner]) != 1 || len(byPlayer[other]) != 0 {
+		t.Errorf("Purged card was not attributed to its owner alone!")
+	}
+
+	e = keyforge.Purge(creatures[0])
+
+	if e == nil {
+		t.Error("Purged a card that was already purged!")
+	}
+}
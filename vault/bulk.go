@@ -0,0 +1,104 @@
+package keyforgevault
+
+import (
+	"context"
+	keyforge "keyforge/game"
+	"sync"
+)
+
+// DefaultWorkers - Number of decks NewClient retrieves at once.
+const DefaultWorkers = 4
+
+// DeckResult - The outcome of retrieving one deck in bulk.
+type DeckResult struct {
+	ID   string
+	Deck keyforge.Deck
+	Err  error
+}
+
+// BulkResult - The outcome of retrieving decks in bulk. Results holds one
+// entry per requested ID, in the order requested. Cards holds every
+// distinct card found in the retrieved decks, leaving out maverick copies
+// as they share the ID of the card they were printed from.
+type BulkResult struct {
+	Results []DeckResult
+	Cards   []keyforge.Card
+}
+
+// RetrieveDecks - Retrieves many decks at once.
+func (c *Client) RetrieveDecks(deckIDs []string) (BulkResult, error) {
+	return c.RetrieveDecksContext(context.Background(), deckIDs)
+}
+
+// RetrieveDecksContext - Retrieves many decks using up to Workers requests
+// at a time, all sharing the client's rate limiter. A deck requested more
+// than once is only retrieved once. Failures are reported per deck; the
+// returned error is only set if the cards could not be added to the
+// cache's card database, which is updated once for the whole batch.
+func (c *Client) RetrieveDecksContext(ctx context.Context, deckIDs []string) (BulkResult, error) {
+	unique := []string{}
+	seen := map[string]bool{}
+
+	for _, deckID := range deckIDs {
+		if !seen[deckID] {
+			seen[deckID] = true
+			unique = append(unique, deckID)
+		}
+	}
+
+	workers := c.Workers
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobs := make(chan string)
+	retrieved := map[string]DeckResult{}
+	mutex := sync.Mutex{}
+	group := sync.WaitGroup{}
+
+	for i := 0; i < workers; i++ {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			for deckID := range jobs {
+				deck, e := c.retrieveDeck(ctx, deckID, false)
+
+				mutex.Lock()
+				retrieved[deckID] = DeckResult{ID: deckID, Deck: deck, Err: e}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for _, deckID := range unique {
+		jobs <- deckID
+	}
+
+	close(jobs)
+	group.Wait()
+
+	bulk := BulkResult{Cards: []keyforge.Card{}}
+	cardSeen := map[string]bool{}
+
+	for _, deckID := range deckIDs {
+		bulk.Results = append(bulk.Results, retrieved[deckID])
+	}
+
+	for _, deckID := range unique {
+		for _, card := range retrieved[deckID].Deck.Cards {
+			if !card.IsMaverick && !cardSeen[card.ID] {
+				cardSeen[card.ID] = true
+				bulk.Cards = append(bulk.Cards, card)
+			}
+		}
+	}
+
+	if c.Cache != nil && len(bulk.Cards) > 0 {
+		return bulk, c.Cache.StoreCards(bulk.Cards)
+	}
+
+	return bulk, nil
+}
@@ -0,0 +1,87 @@
+package keyforgevault
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRetrieveDecks(t *testing.T) {
+	fake, client := loggedInFake(t)
+	deckIDs := []string{}
+	unique := map[string]bool{}
+
+	for _, deck := range fake.Decks {
+		deckIDs = append(deckIDs, deck.Deck.ID)
+
+		for _, card := range deck.Linked.Cards {
+			unique[card.ID] = true
+		}
+	}
+
+	deckIDs = append(deckIDs, fake.Decks[0].Deck.ID, "no-such-deck")
+
+	bulk, e := client.RetrieveDecks(deckIDs)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if len(bulk.Results) != len(deckIDs) {
+		t.Fatalf("Returned %d results! Should be %d.", len(bulk.Results), len(deckIDs))
+	}
+
+	for i, result := range bulk.Results[:fakeDeckCount+1] {
+		if result.Err != nil || result.ID != deckIDs[i] || result.Deck.ID != deckIDs[i] {
+			t.Errorf("Result %d is for %s with error %v! Should be deck %s.", i, result.Deck.ID, result.Err, deckIDs[i])
+		}
+	}
+
+	if !errors.Is(bulk.Results[fakeDeckCount+1].Err, ErrNotFound) {
+		t.Error("The missing deck should be reported as not found!")
+	}
+
+	if hits := fake.Hits("/api/decks/" + fake.Decks[0].Deck.ID + "/"); hits != 1 {
+		t.Errorf("Requested the duplicated deck %d times! Should be once.", hits)
+	}
+
+	if len(bulk.Cards) != len(unique) {
+		t.Errorf("Returned %d distinct cards! Should be %d.", len(bulk.Cards), len(unique))
+	}
+}
+
+func TestRetrieveDecksWorkers(t *testing.T) {
+	fake, client := loggedInFake(t)
+	client.Workers = 2
+	deckIDs := []string{}
+
+	for _, deck := range fake.Decks {
+		deckIDs = append(deckIDs, deck.Deck.ID)
+	}
+
+	client.RetrieveDecks(deckIDs)
+
+	if fake.MaxInFlight() > 2 {
+		t.Errorf("Made %d requests at once! Should be at most 2.", fake.MaxInFlight())
+	}
+}
+
+func TestRetrieveDecksCardDatabase(t *testing.T) {
+	fake, client := cachingFake(t)
+	deckIDs := []string{fake.Decks[0].Deck.ID, fake.Decks[1].Deck.ID}
+
+	bulk, e := client.RetrieveDecks(deckIDs)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	cards, e := client.Cache.LoadCards()
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if len(cards) != len(bulk.Cards) {
+		t.Errorf("Card database holds %d cards! Should be %d.", len(cards), len(bulk.Cards))
+	}
+}
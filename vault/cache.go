@@ -0,0 +1,182 @@
+package keyforgevault
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	keyforge "keyforge/game"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrNotCached - Returned by a cache that does not hold the requested deck,
+// and by an offline client asked for a deck it has not cached.
+var ErrNotCached = errors.New("vault: deck not cached")
+
+// CachedDeck - A deck as stored in a cache, along with when it was last
+// retrieved from the Vault.
+type CachedDeck struct {
+	Deck        keyforge.Deck `json:"deck"`
+	RetrievedAt time.Time     `json:"retrieved_at"`
+}
+
+// Cache - This interface is implemented by anything able to store decks
+// retrieved from the Vault and the cards linked to them. LoadDeck returns
+// ErrNotCached for decks it does not hold. StoreCards adds cards to the
+// card database, replacing cards with the same ID. Maverick copies share
+// the ID of the card they were printed from, so they are left out rather
+// than replacing it.
+type Cache interface {
+	LoadDeck(deckID string) (CachedDeck, error)
+	StoreDeck(deck CachedDeck) error
+	LoadCards() ([]keyforge.Card, error)
+	StoreCards(cards []keyforge.Card) error
+}
+
+// FileCache - A cache keeping each deck in its own JSON file and the card
+// database in a single cards.json, all within Directory. It is safe to use
+// from several goroutines.
+type FileCache struct {
+	Directory string
+
+	mutex sync.Mutex
+}
+
+// NewFileCache - Returns a file cache in the given directory, creating it
+// if needed.
+func NewFileCache(directory string) (*FileCache, error) {
+	e := os.MkdirAll(filepath.Join(directory, "decks"), 0755)
+
+	if e != nil {
+		return nil, e
+	}
+
+	return &FileCache{Directory: directory}, nil
+}
+
+// deckPath - Returns the file a deck is stored in.
+func (f *FileCache) deckPath(deckID string) string {
+	return filepath.Join(f.Directory, "decks", filepath.Base(deckID)+".json")
+}
+
+// cardsPath - Returns the file the card database is stored in.
+func (f *FileCache) cardsPath() string {
+	return filepath.Join(f.Directory, "cards.json")
+}
+
+// LoadDeck - Cache implementation.
+func (f *FileCache) LoadDeck(deckID string) (CachedDeck, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	cached := CachedDeck{}
+	data, e := ioutil.ReadFile(f.deckPath(deckID))
+
+	if os.IsNotExist(e) {
+		return cached, ErrNotCached
+	}
+
+	if e != nil {
+		return cached, e
+	}
+
+	e = json.Unmarshal(data, &cached)
+	return cached, e
+}
+
+// StoreDeck - Cache implementation.
+func (f *FileCache) StoreDeck(deck CachedDeck) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return writeJSON(f.deckPath(deck.Deck.ID), deck)
+}
+
+// LoadCards - Cache implementation. Cards are returned ordered by expansion
+// and card number.
+func (f *FileCache) LoadCards() ([]keyforge.Card, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.loadCards()
+}
+
+// loadCards - Reads the card database. The caller must hold the mutex.
+func (f *FileCache) loadCards() ([]keyforge.Card, error) {
+	cards := []keyforge.Card{}
+	data, e := ioutil.ReadFile(f.cardsPath())
+
+	if os.IsNotExist(e) {
+		return cards, nil
+	}
+
+	if e != nil {
+		return cards, e
+	}
+
+	e = json.Unmarshal(data, &cards)
+	return cards, e
+}
+
+// StoreCards - Cache implementation.
+func (f *FileCache) StoreCards(cards []keyforge.Card) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	stored, e := f.loadCards()
+
+	if e != nil {
+		return e
+	}
+
+	byID := map[string]keyforge.Card{}
+
+	for _, card := range append(stored, cards...) {
+		if !card.IsMaverick {
+			byID[card.ID] = card
+		}
+	}
+
+	merged := []keyforge.Card{}
+
+	for _, card := range byID {
+		merged = append(merged, card)
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].Expansion != merged[j].Expansion {
+			return merged[i].Expansion < merged[j].Expansion
+		}
+
+		if merged[i].CardNumber != merged[j].CardNumber {
+			return merged[i].CardNumber < merged[j].CardNumber
+		}
+
+		return merged[i].ID < merged[j].ID
+	})
+
+	return writeJSON(f.cardsPath(), merged)
+}
+
+// writeJSON - Writes a value to a file as JSON. The file is written in
+// full before replacing any previous version, so readers never see a
+// partial file.
+func writeJSON(path string, value interface{}) error {
+	data, e := json.MarshalIndent(value, "", "  ")
+
+	if e != nil {
+		return e
+	}
+
+	temporary := path + ".tmp"
+	e = ioutil.WriteFile(temporary, data, 0644)
+
+	if e != nil {
+		return e
+	}
+
+	return os.Rename(temporary, path)
+}
@@ -0,0 +1,173 @@
+package keyforgevault
+
+import (
+	"errors"
+	keyforge "keyforge/game"
+	"testing"
+	"time"
+)
+
+// cachingFake - Starts a fake Vault and returns a logged in client caching
+// decks in a temporary directory.
+func cachingFake(t *testing.T) (*fakeVault, *Client) {
+	fake, client := loggedInFake(t)
+	cache, e := NewFileCache(t.TempDir())
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	client.Cache = cache
+	client.CacheTTL = time.Hour
+
+	return fake, client
+}
+
+func TestCacheRetrieveDeck(t *testing.T) {
+	fake, client := cachingFake(t)
+	deckID := fake.Decks[0].Deck.ID
+	path := "/api/decks/" + deckID + "/"
+
+	first, e := client.RetrieveDeck(deckID)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	second, e := client.RetrieveDeck(deckID)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if fake.Hits(path) != 1 {
+		t.Errorf("Requested the deck %d times! Should be once.", fake.Hits(path))
+	}
+
+	if second.Name != first.Name || len(second.Cards) != len(first.Cards) {
+		t.Error("The cached deck does not match the retrieved deck!")
+	}
+}
+
+func TestCacheRefreshesMutableFields(t *testing.T) {
+	fake, client := cachingFake(t)
+	deckID := fake.Decks[0].Deck.ID
+
+	client.RetrieveDeck(deckID)
+
+	fake.Decks[0].Deck.Wins = 5
+	client.CacheTTL = 0
+
+	deck, e := client.RetrieveDeck(deckID)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if deck.Wins != 5 {
+		t.Errorf("Refreshed deck has %d wins! Should be 5.", deck.Wins)
+	}
+
+	if len(deck.Cards) != 36 {
+		t.Errorf("Refreshed deck has %d cards! Should keep its 36 cached cards.", len(deck.Cards))
+	}
+}
+
+func TestCacheOffline(t *testing.T) {
+	fake, client := cachingFake(t)
+	deckID := fake.Decks[0].Deck.ID
+
+	client.RetrieveDeck(deckID)
+	fake.Server.Close()
+
+	client.Offline = true
+	client.CacheTTL = 0
+
+	deck, e := client.RetrieveDeck(deckID)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if deck.ID != deckID {
+		t.Error("The offline client did not return the cached deck!")
+	}
+
+	_, e = client.RetrieveDeck(fake.Decks[1].Deck.ID)
+
+	if !errors.Is(e, ErrNotCached) {
+		t.Errorf("Retrieving an uncached deck offline returned %v! Should be ErrNotCached.", e)
+	}
+}
+
+func TestCacheOfflineWithoutCache(t *testing.T) {
+	fake, client := loggedInFake(t)
+	deckID := fake.Decks[0].Deck.ID
+	client.Offline = true
+
+	_, e := client.RetrieveDeck(deckID)
+
+	if !errors.Is(e, ErrNotCached) {
+		t.Errorf("Retrieving a deck offline without a cache returned %v! Should be ErrNotCached.", e)
+	}
+
+	if hits := fake.Hits("/api/decks/" + deckID + "/"); hits != 0 {
+		t.Errorf("The offline client requested the deck %d times! Should be none.", hits)
+	}
+}
+
+func TestCacheCardDatabase(t *testing.T) {
+	fake, client := cachingFake(t)
+	unique := map[string]bool{}
+
+	for _, deck := range fake.Decks[:2] {
+		client.RetrieveDeck(deck.Deck.ID)
+
+		for _, card := range deck.Linked.Cards {
+			unique[card.ID] = true
+		}
+	}
+
+	cards, e := client.Cache.LoadCards()
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if len(cards) != len(unique) {
+		t.Errorf("Card database holds %d cards! Should be %d.", len(cards), len(unique))
+	}
+}
+
+func TestCacheCardDatabaseMavericks(t *testing.T) {
+	cache, e := NewFileCache(t.TempDir())
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	anger := keyforge.Card{ID: "anger", CardTitle: "Anger", House: "Brobnar"}
+	maverick := keyforge.Card{ID: "anger", CardTitle: "Anger", House: "Dis", IsMaverick: true}
+
+	e = cache.StoreCards([]keyforge.Card{anger, maverick})
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	e = cache.StoreCards([]keyforge.Card{maverick})
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	cards, e := cache.LoadCards()
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if len(cards) != 1 || cards[0].House != "Brobnar" || cards[0].IsMaverick {
+		t.Errorf("Card database holds %v! Should only hold the Brobnar Anger.", cards)
+	}
+}
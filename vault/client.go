@@ -0,0 +1,585 @@
+package keyforgevault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	keyforge "keyforge/game"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Default endpoints and settings used by NewClient.
+const (
+	DefaultVaultURL   = "https://www.keyforgegame.com"
+	DefaultAccountURL = "https://account.asmodee.net"
+	DefaultUserAgent  = "keyforge-go"
+)
+
+// loginNonce - Nonce sent with Asmodee sign in requests.
+const loginNonce = "4HM~Z5f8"
+
+// Client - This type holds everything needed to talk to the Vault: the base
+// URLs of the Vault and the Asmodee account service, the HTTP client used
+// for requests, the user agent sent with them and the user's credentials.
+// User is filled in by Login and its token authorizes later requests.
+// Requests wait on RateLimiter, if set, and failures are retried according
+// to RetryPolicy. Retrieved decks are kept in Cache, if set; see
+// RetrieveDeckContext.
+type Client struct {
+	VaultURL    string
+	AccountURL  string
+	HTTPClient  *http.Client
+	UserAgent   string
+	UserName    string
+	Password    string
+	User        VaultUser
+	RateLimiter *RateLimiter
+	RetryPolicy RetryPolicy
+	Cache       Cache
+	CacheTTL    time.Duration
+	Offline     bool
+	Workers     int
+
+	// sleep - Waits between retries. Replaced in tests.
+	sleep func(ctx context.Context, duration time.Duration) error
+}
+
+// NewClient - Returns a client for the live Vault using the given
+// credentials, a default HTTP client, the default rate limit and the
+// default retry policy.
+func NewClient(userName, password string) *Client {
+	// The default rate limit is always valid.
+	limiter, _ := NewRateLimiter(DefaultRequestsPerSecond, DefaultBurst)
+
+	return &Client{
+		VaultURL:    DefaultVaultURL,
+		AccountURL:  DefaultAccountURL,
+		HTTPClient:  &http.Client{},
+		UserAgent:   DefaultUserAgent,
+		UserName:    userName,
+		Password:    password,
+		RateLimiter: limiter,
+		RetryPolicy: DefaultRetryPolicy,
+		Workers:     DefaultWorkers,
+	}
+}
+
+// httpClient - Returns the HTTP client used for requests, falling back to
+// the default client if none was injected.
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+
+	return c.HTTPClient
+}
+
+// newRequest - Builds a request to the given URL carrying the client's user
+// agent and, once logged in, the user's token. The request is cancelled
+// along with ctx.
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	request, e := http.NewRequestWithContext(ctx, method, path, body)
+
+	if e != nil {
+		return nil, e
+	}
+
+	if len(c.UserAgent) > 0 {
+		request.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	if len(c.User.Token) > 0 {
+		request.Header.Set("Authorization", fmt.Sprintf("Token %s", c.User.Token))
+	}
+
+	return request, nil
+}
+
+// do - Sends a request and decodes the JSON response into result. Error
+// status codes and bodies that cannot be decoded are returned as typed
+// errors. Each attempt waits on the rate limiter, and failed attempts are
+// retried according to the retry policy.
+func (c *Client) do(client *http.Client, request *http.Request, result interface{}) error {
+	ctx := request.Context()
+
+	for attempt := 0; ; attempt++ {
+		e := c.wait(ctx)
+
+		if e != nil {
+			return e
+		}
+
+		e = c.attempt(client, request, result)
+		delay, retry := c.RetryPolicy.retryDelay(attempt, e)
+
+		if !retry {
+			return e
+		}
+
+		sleeper := c.sleep
+
+		if sleeper == nil {
+			sleeper = sleep
+		}
+
+		if e = sleeper(ctx, delay); e != nil {
+			return e
+		}
+
+		request, e = rewind(request)
+
+		if e != nil {
+			return e
+		}
+	}
+}
+
+// wait - Waits on the client's rate limiter, if it has one.
+func (c *Client) wait(ctx context.Context) error {
+	if c.RateLimiter == nil {
+		return nil
+	}
+
+	return c.RateLimiter.Wait(ctx)
+}
+
+// rewind - Returns a copy of a request with a fresh body so it can be sent
+// again.
+func rewind(request *http.Request) (*http.Request, error) {
+	retry := request.Clone(request.Context())
+
+	if request.GetBody != nil {
+		body, e := request.GetBody()
+
+		if e != nil {
+			return nil, e
+		}
+
+		retry.Body = body
+	}
+
+	return retry, nil
+}
+
+// attempt - Sends a request once and decodes the JSON response into result.
+func (c *Client) attempt(client *http.Client, request *http.Request, result interface{}) error {
+	response, e := client.Do(request)
+
+	if e != nil {
+		return e
+	}
+
+	body, e := ioutil.ReadAll(response.Body)
+	defer response.Body.Close()
+
+	if e != nil {
+		return e
+	}
+
+	e = checkResponse(response, body)
+
+	if e != nil {
+		return e
+	}
+
+	e = json.Unmarshal(body, result)
+
+	if e != nil {
+		return newResponseError(response.StatusCode, body)
+	}
+
+	return nil
+}
+
+// Login - Signs in to the Asmodee account service with the client's
+// credentials and exchanges the resulting tokens for a Vault user. The user
+// is stored on the client so later requests are authorized.
+func (c *Client) Login() (VaultUser, error) {
+	return c.LoginContext(context.Background())
+}
+
+// LoginContext - Login, giving up once ctx is cancelled or its deadline
+// passes. The deadline covers both the sign in redirect and the Vault login.
+func (c *Client) LoginContext(ctx context.Context) (VaultUser, error) {
+	credentials := Credentials{}
+
+	// The sign in response redirects back to the Vault with the tokens in
+	// the URL fragment, so the redirect must not be followed.
+	client := *c.httpClient()
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	params := url.Values{}
+	params.Add("display", "popup")
+	params.Add("scope", "openid profile email")
+	params.Add("response_type", "id_token token")
+	params.Add("client_id", "keyforge-web-portal")
+	params.Add("state", "/")
+	params.Add("redirect_uri", fmt.Sprintf("%s/authorize", c.VaultURL))
+	params.Add("nonce", loginNonce)
+
+	loginForm := url.Values{}
+
+	for key, values := range params {
+		loginForm[key] = values
+	}
+
+	loginForm.Add("login", c.UserName)
+	loginForm.Add("password", c.Password)
+
+	path := fmt.Sprintf("%s/en/signin?%s", c.AccountURL, params.Encode())
+
+	request, e := c.newRequest(ctx, "POST", path, bytes.NewBufferString(loginForm.Encode()))
+
+	if e != nil {
+		return VaultUser{}, e
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	e = c.wait(ctx)
+
+	if e != nil {
+		return VaultUser{}, e
+	}
+
+	response, e := client.Do(request)
+
+	if e != nil {
+		return VaultUser{}, e
+	}
+
+	body, _ := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+
+	// A successful sign in redirects; any other response means the
+	// credentials were refused.
+	if response.StatusCode < 300 || response.StatusCode >= 400 {
+		if e = checkResponse(response, body); e != nil {
+			return VaultUser{}, e
+		}
+
+		return VaultUser{}, ErrUnauthorized
+	}
+
+	redirectLocation, e := response.Location()
+
+	if e != nil {
+		return VaultUser{}, newResponseError(response.StatusCode, body)
+	}
+
+	redirectLocation.RawQuery = redirectLocation.Fragment
+	redirectParams := redirectLocation.Query()
+
+	credentials.AccessToken = redirectParams.Get("access_token")
+	credentials.IDToken = redirectParams.Get("id_token")
+	credentials.Nonce = loginNonce
+
+	jsonObject, e := json.Marshal(&credentials)
+
+	if e != nil {
+		return VaultUser{}, e
+	}
+
+	path = fmt.Sprintf("%s/api/users/login/asmodee/", c.VaultURL)
+
+	request, e = c.newRequest(ctx, "POST", path, bytes.NewBuffer(jsonObject))
+
+	if e != nil {
+		return VaultUser{}, e
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Content-Length", strconv.Itoa(len(jsonObject)))
+
+	userResult := SearchVaultUserJSON{}
+	e = c.do(&client, request, &userResult)
+
+	if e != nil {
+		return VaultUser{}, e
+	}
+
+	if len(userResult.Data.Token) == 0 {
+		return VaultUser{}, &ResponseError{StatusCode: http.StatusOK, Body: "no token in login response"}
+	}
+
+	userResult.Data.User.Token = userResult.Data.Token
+	c.User = userResult.Data.User
+
+	return c.User, nil
+}
+
+// SearchDecks - Searches the Vault for decks matching a query.
+func (c *Client) SearchDecks(deckQuery *DeckQuery) (PartialDeckSearchJSON, error) {
+	return c.SearchDecksContext(context.Background(), deckQuery)
+}
+
+// SearchDecksContext - SearchDecks, giving up once ctx is cancelled or its
+// deadline passes.
+func (c *Client) SearchDecksContext(ctx context.Context, deckQuery *DeckQuery) (PartialDeckSearchJSON, error) {
+	params, e := deckQuery.GetQueryString()
+
+	if e != nil {
+		return PartialDeckSearchJSON{}, e
+	}
+
+	path := fmt.Sprintf("%s/api/decks/?%s", c.VaultURL, params)
+
+	request, e := c.newRequest(ctx, "GET", path, nil)
+
+	if e != nil {
+		return PartialDeckSearchJSON{}, e
+	}
+
+	result := PartialDeckSearchJSON{}
+	e = c.do(c.httpClient(), request, &result)
+
+	if e != nil {
+		return PartialDeckSearchJSON{}, e
+	}
+
+	return result, nil
+}
+
+// RetrieveDeck - Retrieves a deck and its cards from the Vault.
+func (c *Client) RetrieveDeck(deckID string) (keyforge.Deck, error) {
+	return c.RetrieveDeckContext(context.Background(), deckID)
+}
+
+// RetrieveDeckContext - RetrieveDeck, giving up once ctx is cancelled or its
+// deadline passes.
+//
+// With a cache set, cached decks are returned while younger than CacheTTL.
+// Older decks only have their mutable fields, such as wins, losses and
+// chains, refreshed from the Vault since a deck's cards never change.
+// Offline clients answer from the cache alone, returning ErrNotCached for
+// decks they do not have. Decks listing a card that is neither linked nor
+// in the cache's card database fail with an *UnknownCardError.
+func (c *Client) RetrieveDeckContext(ctx context.Context, deckID string) (keyforge.Deck, error) {
+	return c.retrieveDeck(ctx, deckID, true)
+}
+
+// retrieveDeck - Retrieves a deck as RetrieveDeckContext does. The linked
+// cards of newly retrieved decks are only added to the cache's card
+// database if storeCards is set.
+func (c *Client) retrieveDeck(ctx context.Context, deckID string, storeCards bool) (keyforge.Deck, error) {
+	if c.Cache == nil {
+		if c.Offline {
+			return keyforge.Deck{}, ErrNotCached
+		}
+
+		deckJSON, e := c.fetchDeck(ctx, deckID, true)
+
+		if e != nil {
+			return keyforge.Deck{}, e
+		}
+
+		return convertDeck(deckJSON, nil)
+	}
+
+	cached, e := c.Cache.LoadDeck(deckID)
+
+	if e != nil && !errors.Is(e, ErrNotCached) {
+		return keyforge.Deck{}, e
+	}
+
+	if e == nil && (c.Offline || time.Since(cached.RetrievedAt) < c.CacheTTL) {
+		return cached.Deck, nil
+	}
+
+	if c.Offline {
+		return keyforge.Deck{}, ErrNotCached
+	}
+
+	// A cached deck only needs its mutable fields refreshed, so the linked
+	// cards are not requested again.
+	deckJSON, e := c.fetchDeck(ctx, deckID, e != nil)
+
+	if e != nil {
+		return keyforge.Deck{}, e
+	}
+
+	deck := deckDetails(deckJSON)
+
+	if len(cached.Deck.CardList) > 0 {
+		deck.CardList = cached.Deck.CardList
+		deck.Cards = cached.Deck.Cards
+		deck.Houses = cached.Deck.Houses
+		deck.HouseImages = cached.Deck.HouseImages
+	} else {
+		deck, e = convertDeck(deckJSON, nil)
+
+		// Cards the Vault did not link may already be in the card database.
+		if errors.Is(e, ErrUnknownCard) {
+			known, loadErr := c.Cache.LoadCards()
+
+			if loadErr != nil {
+				return keyforge.Deck{}, loadErr
+			}
+
+			deck, e = convertDeck(deckJSON, known)
+		}
+
+		if e != nil {
+			return keyforge.Deck{}, e
+		}
+
+		if storeCards {
+			e = c.Cache.StoreCards(deckJSON.Linked.Cards)
+
+			if e != nil {
+				return keyforge.Deck{}, e
+			}
+		}
+	}
+
+	e = c.Cache.StoreDeck(CachedDeck{Deck: deck, RetrievedAt: time.Now()})
+
+	if e != nil {
+		return keyforge.Deck{}, e
+	}
+
+	return deck, nil
+}
+
+// fetchDeck - Requests a deck from the Vault, along with its linked cards
+// and notes if withLinks is set.
+func (c *Client) fetchDeck(ctx context.Context, deckID string, withLinks bool) (RetrieveDeckJSON, error) {
+	deckJSON := RetrieveDeckJSON{}
+	path := fmt.Sprintf("%s/api/decks/%s/", c.VaultURL, deckID)
+
+	if withLinks {
+		path += "?links=cards,notes"
+	}
+
+	request, e := c.newRequest(ctx, "GET", path, nil)
+
+	if e != nil {
+		return deckJSON, e
+	}
+
+	e = c.do(c.httpClient(), request, &deckJSON)
+	return deckJSON, e
+}
+
+// convertDeck - Converts a deck retrieved from the Vault into a game deck.
+// Cards the Vault did not link are looked up in known. Returns an
+// *UnknownCardError if any card could not be found in either.
+func convertDeck(deckJSON RetrieveDeckJSON, known []keyforge.Card) (keyforge.Deck, error) {
+	newDeck := deckDetails(deckJSON)
+	newDeck.Houses, newDeck.HouseImages = convertHouses(deckJSON)
+	newDeck.CardList = deckJSON.Deck.Links.CardList
+
+	cards, missing := resolveCards(newDeck.CardList, deckJSON.Linked.Cards, known, newDeck.Houses)
+
+	if len(missing) > 0 {
+		return keyforge.Deck{}, &UnknownCardError{DeckID: newDeck.ID, CardIDs: missing}
+	}
+
+	newDeck.Cards = cards
+	return newDeck, nil
+}
+
+// deckDetails - Converts the fields of a deck retrieved from the Vault
+// which do not depend on its linked cards or houses.
+func deckDetails(deckJSON RetrieveDeckJSON) keyforge.Deck {
+	newDeck := keyforge.Deck{}
+
+	newDeck.CasualLosses = deckJSON.Deck.CasualLosses
+	newDeck.CasualWins = deckJSON.Deck.CasualWins
+	newDeck.Chains = deckJSON.Deck.Chains
+	newDeck.Expansion = deckJSON.Deck.Expansion
+	newDeck.ID = deckJSON.Deck.ID
+	newDeck.IsMyDeck = deckJSON.Deck.IsMyDeck
+	newDeck.IsMyFavorite = deckJSON.Deck.IsMyFavorite
+	newDeck.IsOnWatchList = deckJSON.Deck.IsOnWatchList
+	newDeck.Losses = deckJSON.Deck.Losses
+	newDeck.Name = deckJSON.Deck.Name
+	newDeck.Notes = deckJSON.Deck.Notes
+	newDeck.Wins = deckJSON.Deck.Wins
+
+	return newDeck
+}
+
+// convertHouses - Returns the names of a deck's houses, in the order the
+// deck lists them, along with each house's image keyed by name. Houses
+// missing from the linked houses are named by their ID.
+func convertHouses(deckJSON RetrieveDeckJSON) ([]string, map[string]string) {
+	houses := []string{}
+	images := map[string]string{}
+
+	for _, houseID := range deckJSON.Deck.Links.Houses {
+		name := houseID
+
+		for _, house := range deckJSON.Linked.Houses {
+			if house.ID == houseID {
+				name = house.Name
+				images[name] = house.Image
+				break
+			}
+		}
+
+		houses = append(houses, name)
+	}
+
+	return houses, images
+}
+
+// resolveCards - Returns one card for each ID in a deck's card list. A
+// maverick shares its ID with the card it was printed from, so the linked
+// cards may hold both versions of one ID. Each linked maverick fills one
+// copy of its ID, and every other copy uses the ordinary version, taken
+// from the deck's houses whenever such a version is linked or known. IDs
+// with no version left to use are returned as missing.
+func resolveCards(cardList []string, linked []keyforge.Card, known []keyforge.Card, houses []string) ([]keyforge.Card, []string) {
+	mavericks := map[string][]keyforge.Card{}
+	standard := map[string]keyforge.Card{}
+
+	consider := func(card keyforge.Card) {
+		current, found := standard[card.ID]
+
+		if !found || (!keyforge.HouseExists(houses, current.House) && keyforge.HouseExists(houses, card.House)) {
+			standard[card.ID] = card
+		}
+	}
+
+	for _, card := range linked {
+		if card.IsMaverick {
+			mavericks[card.ID] = append(mavericks[card.ID], card)
+		} else {
+			consider(card)
+		}
+	}
+
+	for _, card := range known {
+		if !card.IsMaverick {
+			consider(card)
+		}
+	}
+
+	cards := []keyforge.Card{}
+	missing := []string{}
+	reported := map[string]bool{}
+
+	for _, cardID := range cardList {
+		if unused := mavericks[cardID]; len(unused) > 0 {
+			cards = append(cards, unused[0])
+			mavericks[cardID] = unused[1:]
+		} else if card, found := standard[cardID]; found {
+			cards = append(cards, card)
+		} else if !reported[cardID] {
+			reported[cardID] = true
+			missing = append(missing, cardID)
+		}
+	}
+
+	return cards, missing
+}
@@ -0,0 +1,55 @@
+package keyforgevault
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientRequestSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/decks/deck-id/" {
+			t.Errorf("Requested %s! Should be /api/decks/deck-id/.", r.URL.Path)
+		}
+
+		if r.Header.Get("User-Agent") != "keyforge-test" {
+			t.Errorf("Sent user agent %s! Should be keyforge-test.", r.Header.Get("User-Agent"))
+		}
+
+		if r.Header.Get("Authorization") != "Token abc" {
+			t.Errorf("Sent authorization %s! Should be Token abc.", r.Header.Get("Authorization"))
+		}
+
+		w.Write([]byte(`{"data": {"id": "deck-id", "name": "Test Deck"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "password")
+	client.VaultURL = server.URL
+	client.HTTPClient = server.Client()
+	client.UserAgent = "keyforge-test"
+	client.User.Token = "abc"
+
+	deck, e := client.RetrieveDeck("deck-id")
+
+	if e != nil {
+		t.Error(e.Error())
+	}
+
+	if deck.Name != "Test Deck" {
+		t.Errorf("Retrieved deck %s! Should be Test Deck.", deck.Name)
+	}
+}
+
+func TestDefaultClientSharesRateLimiter(t *testing.T) {
+	first := defaultClient("", "")
+	second := defaultClient("user", "password")
+
+	if first.RateLimiter == nil || first.RateLimiter != second.RateLimiter {
+		t.Error("Default clients should share one rate limiter!")
+	}
+
+	if NewClient("", "").RateLimiter == first.RateLimiter {
+		t.Error("NewClient should give each client its own rate limiter!")
+	}
+}
@@ -0,0 +1,86 @@
+package keyforgevault
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// slowClient - Returns a client for a server that never answers until the
+// request is abandoned.
+func slowClient(t *testing.T) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The body must be read before the server notices the client
+		// giving up on the request.
+		ioutil.ReadAll(r.Body)
+
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(fakeUserName, fakePassword)
+	client.VaultURL = server.URL
+	client.AccountURL = server.URL
+	client.HTTPClient = server.Client()
+	client.RateLimiter = nil
+	client.RetryPolicy = RetryPolicy{}
+
+	return client
+}
+
+func TestVaultContextDeadline(t *testing.T) {
+	client := slowClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, e := client.SearchDecksContext(ctx, &DeckQuery{})
+
+	if !errors.Is(e, context.DeadlineExceeded) {
+		t.Errorf("Slow search returned %v! Should be context.DeadlineExceeded.", e)
+	}
+
+	_, e = client.RetrieveDeckContext(ctx, "deck-id")
+
+	if !errors.Is(e, context.DeadlineExceeded) {
+		t.Errorf("Slow retrieve returned %v! Should be context.DeadlineExceeded.", e)
+	}
+}
+
+func TestVaultContextLogin(t *testing.T) {
+	client := slowClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, e := client.LoginContext(ctx)
+
+	if !errors.Is(e, context.DeadlineExceeded) {
+		t.Errorf("Slow login returned %v! Should be context.DeadlineExceeded.", e)
+	}
+}
+
+func TestVaultContextCancelled(t *testing.T) {
+	fake := newFakeVault(t)
+	client := fake.Client(fakeUserName, fakePassword)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, e := client.LoginContext(ctx)
+
+	if !errors.Is(e, context.Canceled) {
+		t.Errorf("Cancelled login returned %v! Should be context.Canceled.", e)
+	}
+
+	if fake.Hits("/en/signin") != 0 {
+		t.Error("A cancelled login should not reach the server!")
+	}
+}
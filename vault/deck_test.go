@@ -0,0 +1,159 @@
+package keyforgevault
+
+import (
+	"errors"
+	keyforge "keyforge/game"
+	"testing"
+)
+
+func TestVaultSearchDecks(t *testing.T) {
+	fake := newFakeVault(t)
+	client := fake.Client(fakeUserName, fakePassword)
+
+	_, e := client.Login()
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	result, e := client.SearchDecks(&DeckQuery{Query: "Fake Deck", Page: 2, PageSize: 2})
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if result.Count != fakeDeckCount {
+		t.Errorf("Search found %d decks! Should be %d.", result.Count, fakeDeckCount)
+	}
+
+	if len(result.Decks) != 2 || result.Decks[0].Name != "Fake Deck 3" {
+		t.Error("Search did not return the second page of decks!")
+	}
+}
+
+func TestVaultRetrieveDeck(t *testing.T) {
+	fake := newFakeVault(t)
+	client := fake.Client(fakeUserName, fakePassword)
+
+	_, e := client.Login()
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	expected := fake.Decks[0].Deck
+	deck, e := client.RetrieveDeck(expected.ID)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if deck.Name != expected.Name {
+		t.Errorf("Retrieved deck %s! Should be %s.", deck.Name, expected.Name)
+	}
+
+	if len(deck.Cards) != 36 {
+		t.Errorf("Retrieved deck has %d cards! Should be 36.", len(deck.Cards))
+	}
+
+	for i, card := range deck.Cards {
+		if card.ID != deck.CardList[i] {
+			t.Errorf("Card %d is %s! Should be %s.", i, card.ID, deck.CardList[i])
+		}
+	}
+
+	if len(deck.Houses) != 3 {
+		t.Fatalf("Retrieved deck has %d houses! Should be 3.", len(deck.Houses))
+	}
+
+	for i, house := range expected.Links.Houses {
+		if deck.Houses[i] != house {
+			t.Errorf("House %d is %s! Should be %s.", i, deck.Houses[i], house)
+		}
+
+		if deck.HouseImages[house] != fakeHouseImage(house) {
+			t.Errorf("%s has image %s! Should be %s.", house, deck.HouseImages[house], fakeHouseImage(house))
+		}
+	}
+}
+
+func TestConvertDeckMavericks(t *testing.T) {
+	deckJSON := RetrieveDeckJSON{}
+	deckJSON.Deck.Links.Houses = []string{"Brobnar", "Dis"}
+	deckJSON.Deck.Links.CardList = []string{"anger", "anger", "troll", "anger", "missing"}
+	deckJSON.Linked.Houses = []HouseJSON{
+		{ID: "Brobnar", Name: "Brobnar"},
+		{ID: "Dis", Name: "Dis"},
+	}
+	deckJSON.Linked.Cards = []keyforge.Card{
+		{ID: "troll", CardTitle: "Troll", House: "Shadows"},
+		{ID: "troll", CardTitle: "Troll", House: "Brobnar"},
+		{ID: "anger", CardTitle: "Anger", House: "Dis", IsMaverick: true},
+		{ID: "anger", CardTitle: "Anger", House: "Brobnar"},
+	}
+
+	_, e := convertDeck(deckJSON, nil)
+	unknown := &UnknownCardError{}
+
+	if !errors.As(e, &unknown) || len(unknown.CardIDs) != 1 || unknown.CardIDs[0] != "missing" {
+		t.Fatalf("Converting a deck with an unknown card returned %v! Should report the missing card.", e)
+	}
+
+	known := []keyforge.Card{{ID: "missing", CardTitle: "Missing", House: "Dis"}}
+	deck, e := convertDeck(deckJSON, known)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if len(deck.Cards) != len(deck.CardList) {
+		t.Fatalf("Deck has %d cards! Should be %d.", len(deck.Cards), len(deck.CardList))
+	}
+
+	expected := []string{"Dis", "Brobnar", "Brobnar", "Brobnar", "Dis"}
+
+	for i, card := range deck.Cards {
+		if card.ID != deck.CardList[i] || card.House != expected[i] {
+			t.Errorf("Card %d is %s from %s! Should be %s from %s.", i, card.ID, card.House, deck.CardList[i], expected[i])
+		}
+	}
+
+	mavericks := 0
+
+	for _, card := range deck.Cards {
+		if card.IsMaverick {
+			mavericks++
+		}
+	}
+
+	if !deck.Cards[0].IsMaverick || mavericks != 1 {
+		t.Errorf("Deck has %d mavericks! Only the first Anger should be the maverick copy.", mavericks)
+	}
+}
+
+func TestVaultRetrieveDeckUnknownCard(t *testing.T) {
+	fake, client := cachingFake(t)
+	deckJSON := &fake.Decks[0]
+	missing := deckJSON.Linked.Cards[0]
+	deckJSON.Linked.Cards = deckJSON.Linked.Cards[1:]
+
+	_, e := client.RetrieveDeck(deckJSON.Deck.ID)
+
+	if !errors.Is(e, ErrUnknownCard) {
+		t.Fatalf("Retrieving a deck with an unknown card returned %v! Should be ErrUnknownCard.", e)
+	}
+
+	client.Cache.StoreCards([]keyforge.Card{missing})
+
+	deck, e := client.RetrieveDeck(deckJSON.Deck.ID)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	for _, card := range deck.Cards {
+		if len(card.CardTitle) == 0 {
+			t.Fatalf("Card %s has no data!", card.ID)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package keyforgevault
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Errors returned by Vault calls. Rate limit, bad response and unknown card
+// errors carry more detail as *RateLimitError, *ResponseError and
+// *UnknownCardError, and can be told apart with errors.Is.
+var (
+	ErrUnauthorized = errors.New("vault: unauthorized")
+	ErrNotFound     = errors.New("vault: not found")
+	ErrRateLimited  = errors.New("vault: rate limited")
+	ErrBadResponse  = errors.New("vault: bad response")
+	ErrUnknownCard  = errors.New("vault: unknown card")
+)
+
+// excerptLength - Maximum number of body bytes kept in a ResponseError.
+const excerptLength = 200
+
+// RateLimitError - Returned when the Vault rejects a request for being
+// over its rate limit. RetryAfter is how long the Vault asked us to wait,
+// or zero if it did not say.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (r *RateLimitError) Error() string {
+	if r.RetryAfter > 0 {
+		return fmt.Sprintf("%s, retry after %s", ErrRateLimited, r.RetryAfter)
+	}
+
+	return ErrRateLimited.Error()
+}
+
+// Unwrap - Allows errors.Is to match ErrRateLimited.
+func (r *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// ResponseError - Returned when the Vault answers with an unexpected status
+// code or a body that cannot be understood. Body holds the start of the
+// response body to help work out what went wrong.
+type ResponseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (r *ResponseError) Error() string {
+	return fmt.Sprintf("%s: status %d: %s", ErrBadResponse, r.StatusCode, r.Body)
+}
+
+// Unwrap - Allows errors.Is to match ErrBadResponse.
+func (r *ResponseError) Unwrap() error {
+	return ErrBadResponse
+}
+
+// UnknownCardError - Returned when a deck lists cards that are neither
+// linked to it by the Vault nor held in the cache's card database.
+type UnknownCardError struct {
+	DeckID  string
+	CardIDs []string
+}
+
+func (u *UnknownCardError) Error() string {
+	return fmt.Sprintf("%s: deck %s: %s", ErrUnknownCard, u.DeckID, strings.Join(u.CardIDs, ", "))
+}
+
+// Unwrap - Allows errors.Is to match ErrUnknownCard.
+func (u *UnknownCardError) Unwrap() error {
+	return ErrUnknownCard
+}
+
+// newResponseError - Returns a ResponseError with an excerpt of the body.
+func newResponseError(statusCode int, body []byte) *ResponseError {
+	if len(body) > excerptLength {
+		body = body[:excerptLength]
+	}
+
+	return &ResponseError{StatusCode: statusCode, Body: string(body)}
+}
+
+// checkResponse - Returns the error matching a response's status code, or
+// nil for a successful response.
+func checkResponse(response *http.Response, body []byte) error {
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return nil
+	case response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case response.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case response.StatusCode == http.StatusTooManyRequests:
+		return &RateLimitError{RetryAfter: retryAfter(response.Header.Get("Retry-After"))}
+	}
+
+	return newResponseError(response.StatusCode, body)
+}
+
+// retryAfter - Parses a Retry-After header given either in seconds or as an
+// HTTP date. Returns zero if the header is missing or malformed.
+func retryAfter(header string) time.Duration {
+	if seconds, e := strconv.Atoi(header); e == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, e := http.ParseTime(header); e == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
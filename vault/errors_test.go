@@ -0,0 +1,111 @@
+package keyforgevault
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// statusServer - Starts a server answering every request with the given
+// status, headers and body.
+func statusServer(t *testing.T, status int, headers map[string]string, body string) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for key, value := range headers {
+			w.Header().Set(key, value)
+		}
+
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(fakeUserName, fakePassword)
+	client.VaultURL = server.URL
+	client.AccountURL = server.URL
+	client.HTTPClient = server.Client()
+	client.RateLimiter = nil
+	client.RetryPolicy = RetryPolicy{}
+
+	return client
+}
+
+func TestVaultErrorUnauthorized(t *testing.T) {
+	fake := newFakeVault(t)
+
+	_, e := fake.Client(fakeUserName, "wrong").Login()
+
+	if !errors.Is(e, ErrUnauthorized) {
+		t.Errorf("Bad password returned %v! Should be ErrUnauthorized.", e)
+	}
+
+	_, e = fake.Client(fakeUserName, fakePassword).SearchDecks(&DeckQuery{})
+
+	if !errors.Is(e, ErrUnauthorized) {
+		t.Errorf("Searching without logging in returned %v! Should be ErrUnauthorized.", e)
+	}
+}
+
+func TestVaultErrorNotFound(t *testing.T) {
+	fake := newFakeVault(t)
+	client := fake.Client(fakeUserName, fakePassword)
+	client.Login()
+
+	_, e := client.RetrieveDeck("no-such-deck")
+
+	if !errors.Is(e, ErrNotFound) {
+		t.Errorf("Retrieving a missing deck returned %v! Should be ErrNotFound.", e)
+	}
+}
+
+func TestVaultErrorRateLimited(t *testing.T) {
+	client := statusServer(t, http.StatusTooManyRequests, map[string]string{"Retry-After": "30"}, "slow down")
+
+	_, e := client.RetrieveDeck("deck-id")
+
+	if !errors.Is(e, ErrRateLimited) {
+		t.Fatalf("Rate limited request returned %v! Should be ErrRateLimited.", e)
+	}
+
+	rateLimit := &RateLimitError{}
+
+	if !errors.As(e, &rateLimit) || rateLimit.RetryAfter != 30*time.Second {
+		t.Error("Rate limit error should carry the Retry-After duration!")
+	}
+}
+
+func TestVaultErrorBadResponse(t *testing.T) {
+	client := statusServer(t, http.StatusInternalServerError, nil, strings.Repeat("x", 1000))
+
+	_, e := client.SearchDecks(&DeckQuery{})
+	responseError := &ResponseError{}
+
+	if !errors.Is(e, ErrBadResponse) || !errors.As(e, &responseError) {
+		t.Fatalf("Server error returned %v! Should be ErrBadResponse.", e)
+	}
+
+	if responseError.StatusCode != http.StatusInternalServerError || len(responseError.Body) != excerptLength {
+		t.Error("Bad response error should carry the status and a body excerpt!")
+	}
+
+	client = statusServer(t, http.StatusOK, nil, "<html>not json</html>")
+
+	_, e = client.RetrieveDeck("deck-id")
+
+	if !errors.Is(e, ErrBadResponse) {
+		t.Errorf("Malformed body returned %v! Should be ErrBadResponse.", e)
+	}
+}
+
+func TestVaultErrorNetwork(t *testing.T) {
+	client := statusServer(t, http.StatusOK, nil, "")
+	client.VaultURL = "http://127.0.0.1:1"
+
+	_, e := client.RetrieveDeck("deck-id")
+
+	if e == nil {
+		t.Error("An unreachable Vault should return an error!")
+	}
+}
@@ -0,0 +1,292 @@
+package keyforgevault
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	keyforge "keyforge/game"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Credentials accepted by the fake Vault.
+const (
+	fakeUserName    = "tester"
+	fakePassword    = "hunter2"
+	fakeAccessToken = "fake-access-token"
+	fakeIDToken     = "fake-id-token"
+	fakeToken       = "fake-vault-token"
+)
+
+// fakeDeckCount - Number of decks served by the fake Vault.
+const fakeDeckCount = 5
+
+// fakeVault - An in-process stand-in for the Asmodee sign in page and the
+// Vault API. Decks are built from the cards in data/cards.json.
+type fakeVault struct {
+	Server *httptest.Server
+	Decks  []RetrieveDeckJSON
+
+	mutex       sync.Mutex
+	hits        map[string]int
+	inFlight    int
+	maxInFlight int
+}
+
+// newFakeVault - Starts a fake Vault. The server is closed when the test
+// finishes.
+func newFakeVault(t *testing.T) *fakeVault {
+	cards := loadFakeCards(t)
+	fake := &fakeVault{Decks: buildFakeDecks(cards, fakeDeckCount), hits: map[string]int{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/en/signin", fake.signIn)
+	mux.HandleFunc("/api/users/login/asmodee/", fake.login)
+	mux.HandleFunc("/api/decks/", fake.decks)
+
+	fake.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fake.mutex.Lock()
+		fake.hits[r.URL.Path]++
+		fake.inFlight++
+
+		if fake.inFlight > fake.maxInFlight {
+			fake.maxInFlight = fake.inFlight
+		}
+
+		fake.mutex.Unlock()
+
+		mux.ServeHTTP(w, r)
+
+		fake.mutex.Lock()
+		fake.inFlight--
+		fake.mutex.Unlock()
+	}))
+
+	t.Cleanup(fake.Server.Close)
+
+	return fake
+}
+
+// Hits - Returns the number of requests made for a path.
+func (f *fakeVault) Hits(path string) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.hits[path]
+}
+
+// MaxInFlight - Returns the most requests the fake has handled at once.
+func (f *fakeVault) MaxInFlight() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.maxInFlight
+}
+
+// Client - Returns a client pointed at the fake Vault.
+func (f *fakeVault) Client(userName, password string) *Client {
+	client := NewClient(userName, password)
+	client.VaultURL = f.Server.URL
+	client.AccountURL = f.Server.URL
+	client.HTTPClient = f.Server.Client()
+	client.RateLimiter = nil
+
+	return client
+}
+
+// loadFakeCards - Loads the card data shipped with the repository.
+func loadFakeCards(t *testing.T) []keyforge.Card {
+	cards := []keyforge.Card{}
+	data, e := ioutil.ReadFile("../data/cards.json")
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	e = json.Unmarshal(data, &cards)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	return cards
+}
+
+// buildFakeDecks - Builds decks of three houses with twelve cards each.
+// Houses are rotated between decks and each deck repeats its first card of
+// every house, as real decks often contain duplicates.
+func buildFakeDecks(cards []keyforge.Card, count int) []RetrieveDeckJSON {
+	byHouse := map[string][]keyforge.Card{}
+
+	for _, card := range cards {
+		byHouse[card.House] = append(byHouse[card.House], card)
+	}
+
+	houses := []string{}
+
+	for house := range byHouse {
+		houses = append(houses, house)
+	}
+
+	sort.Strings(houses)
+
+	decks := []RetrieveDeckJSON{}
+
+	for i := 0; i < count; i++ {
+		deck := RetrieveDeckJSON{}
+		deck.Deck.ID = fmt.Sprintf("00000000-0000-0000-0000-%012d", i+1)
+		deck.Deck.Name = fmt.Sprintf("Fake Deck %d", i+1)
+		deck.Deck.Expansion = 341
+		deck.Deck.Chains = i
+		deck.Deck.Notes = []string{}
+		deck.Linked.Notes = []string{}
+
+		for h := 0; h < 3; h++ {
+			house := houses[(i+h)%len(houses)]
+			houseCards := byHouse[house]
+
+			deck.Deck.Links.Houses = append(deck.Deck.Links.Houses, house)
+			deck.Linked.Houses = append(deck.Linked.Houses, HouseJSON{ID: house, Name: house, Image: fakeHouseImage(house)})
+
+			for c := 0; c < 12; c++ {
+				card := houseCards[(i+c)%len(houseCards)]
+
+				if c == 11 {
+					card = houseCards[i%len(houseCards)]
+				} else {
+					deck.Linked.Cards = append(deck.Linked.Cards, card)
+				}
+
+				deck.Deck.Links.CardList = append(deck.Deck.Links.CardList, card.ID)
+			}
+		}
+
+		decks = append(decks, deck)
+	}
+
+	return decks
+}
+
+// fakeHouseImage - Returns the image URL the fake gives a house.
+func fakeHouseImage(house string) string {
+	return "https://example.com/houses/" + strings.ToLower(house) + ".png"
+}
+
+// signIn - Fakes the Asmodee sign in form, redirecting back to the Vault
+// with tokens in the URL fragment when the credentials are right.
+func (f *fakeVault) signIn(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.ParseForm()
+
+	if r.PostForm.Get("login") != fakeUserName || r.PostForm.Get("password") != fakePassword {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("<html>Invalid login or password</html>"))
+		return
+	}
+
+	redirect := fmt.Sprintf("%s#access_token=%s&id_token=%s&state=/", r.URL.Query().Get("redirect_uri"), fakeAccessToken, fakeIDToken)
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// login - Fakes the Vault login exchanging Asmodee tokens for a Vault user.
+func (f *fakeVault) login(w http.ResponseWriter, r *http.Request) {
+	credentials := Credentials{}
+	e := json.NewDecoder(r.Body).Decode(&credentials)
+
+	if e != nil || credentials.AccessToken != fakeAccessToken || credentials.IDToken != fakeIDToken {
+		http.Error(w, `{"message": "invalid credentials"}`, http.StatusUnauthorized)
+		return
+	}
+
+	result := SearchVaultUserJSON{Data: SearchVaultUser{
+		User:  VaultUser{ID: "fake-user", UserName: fakeUserName, Email: "tester@example.com"},
+		Token: fakeToken,
+	}}
+
+	json.NewEncoder(w).Encode(result)
+}
+
+// decks - Fakes deck search and retrieval.
+func (f *fakeVault) decks(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != fmt.Sprintf("Token %s", fakeToken) {
+		http.Error(w, `{"message": "authentication required"}`, http.StatusUnauthorized)
+		return
+	}
+
+	deckID := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/decks/"), "/")
+
+	if len(deckID) == 0 {
+		f.search(w, r)
+		return
+	}
+
+	for _, deck := range f.Decks {
+		if deck.Deck.ID != deckID {
+			continue
+		}
+
+		if len(r.URL.Query().Get("links")) == 0 {
+			deck.Linked = DeckLinkJSON{}
+		}
+
+		json.NewEncoder(w).Encode(deck)
+		return
+	}
+
+	http.Error(w, `{"message": "deck not found"}`, http.StatusNotFound)
+}
+
+// hasHouses - Determine whether a deck contains every one of the houses.
+func hasHouses(deck RetrieveDeckJSON, houses []string) bool {
+	for _, house := range houses {
+		if !keyforge.HouseExists(deck.Deck.Links.Houses, house) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// search - Serves a page of decks whose names contain the search term.
+func (f *fakeVault) search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+
+	if page < 1 || pageSize < 1 {
+		http.Error(w, `{"message": "invalid page"}`, http.StatusBadRequest)
+		return
+	}
+
+	matches := []PartialDeckJSON{}
+
+	for _, deck := range f.Decks {
+		if !strings.Contains(strings.ToLower(deck.Deck.Name), strings.ToLower(query.Get("search"))) {
+			continue
+		}
+
+		if houses := query.Get("houses"); len(houses) > 0 && !hasHouses(deck, strings.Split(houses, ",")) {
+			continue
+		}
+
+		matches = append(matches, deck.Deck.PartialDeckJSON)
+	}
+
+	result := PartialDeckSearchJSON{Count: len(matches), Decks: []PartialDeckJSON{}}
+	start := (page - 1) * pageSize
+
+	for i := start; i < len(matches) && i < start+pageSize; i++ {
+		result.Decks = append(result.Decks, matches[i])
+	}
+
+	json.NewEncoder(w).Encode(result)
+}
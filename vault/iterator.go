@@ -0,0 +1,118 @@
+package keyforgevault
+
+import (
+	"context"
+)
+
+// IteratorPageSize - Page size used by DeckIterator when the query does not
+// set one.
+const IteratorPageSize = 25
+
+// DeckIterator - Walks every page of results for a deck search, one deck at
+// a time. Call Next until it returns false, then check Err:
+//
+//	decks := client.IterateDecks(ctx, &query, 1000)
+//	for decks.Next() {
+//		deck := decks.Deck()
+//	}
+//	if decks.Err() != nil {
+//		...
+//	}
+type DeckIterator struct {
+	client     *Client
+	ctx        context.Context
+	query      DeckQuery
+	maxResults int
+	decks      []PartialDeckJSON
+	current    PartialDeckJSON
+	yielded    int
+	total      int
+	done       bool
+	err        error
+}
+
+// IterateDecks - Returns an iterator over every deck matching a query,
+// starting from the query's page. At most maxResults decks are returned;
+// zero means no limit. The query is copied, so it is left untouched.
+func (c *Client) IterateDecks(ctx context.Context, deckQuery *DeckQuery, maxResults int) *DeckIterator {
+	query := *deckQuery
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+
+	if query.PageSize == 0 {
+		query.PageSize = IteratorPageSize
+	}
+
+	return &DeckIterator{client: c, ctx: ctx, query: query, maxResults: maxResults}
+}
+
+// Next - Advances to the next deck, fetching the next page when needed.
+// Returns false once every deck has been returned, the result cap has been
+// reached, the context is done or a request fails.
+func (d *DeckIterator) Next() bool {
+	if d.err != nil || (d.maxResults > 0 && d.yielded >= d.maxResults) {
+		return false
+	}
+
+	if e := d.ctx.Err(); e != nil {
+		d.err = e
+		return false
+	}
+
+	if len(d.decks) == 0 && !d.fetch() {
+		return false
+	}
+
+	d.current = d.decks[0]
+	d.decks = d.decks[1:]
+	d.yielded++
+
+	return true
+}
+
+// fetch - Requests the next page of results. Returns false if there are no
+// more results or the request failed.
+func (d *DeckIterator) fetch() bool {
+	if d.done {
+		return false
+	}
+
+	result, e := d.client.SearchDecksContext(d.ctx, &d.query)
+
+	if e != nil {
+		d.err = e
+		return false
+	}
+
+	d.query.Page++
+	d.total = result.Count
+	d.decks = result.Decks
+
+	// A short page or reaching the reported total means this was the last
+	// page. The total counts every matching deck, so it is compared with
+	// the position reached rather than the decks fetched since the first
+	// page requested.
+	if len(result.Decks) < d.query.PageSize || (d.query.Page-1)*d.query.PageSize >= d.total {
+		d.done = true
+	}
+
+	return len(d.decks) > 0
+}
+
+// Deck - Returns the deck Next advanced to.
+func (d *DeckIterator) Deck() PartialDeckJSON {
+	return d.current
+}
+
+// Err - Returns the error that stopped the iterator, if any.
+func (d *DeckIterator) Err() error {
+	return d.err
+}
+
+// Total - Returns the total number of decks matching the query as reported
+// by the Vault, once the first page has been fetched.
+func (d *DeckIterator) Total() int {
+	return d.total
+}
@@ -0,0 +1,127 @@
+package keyforgevault
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// loggedInFake - Starts a fake Vault and returns a client logged into it.
+func loggedInFake(t *testing.T) (*fakeVault, *Client) {
+	fake := newFakeVault(t)
+	client := fake.Client(fakeUserName, fakePassword)
+
+	_, e := client.Login()
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	return fake, client
+}
+
+func TestIterateDecks(t *testing.T) {
+	fake, client := loggedInFake(t)
+	query := DeckQuery{Query: "Fake", PageSize: 2}
+
+	decks := client.IterateDecks(context.Background(), &query, 0)
+	names := []string{}
+
+	for decks.Next() {
+		names = append(names, decks.Deck().Name)
+	}
+
+	if decks.Err() != nil {
+		t.Error(decks.Err().Error())
+	}
+
+	if len(names) != fakeDeckCount || names[4] != "Fake Deck 5" {
+		t.Errorf("Iterated over %v! Should be every fake deck in order.", names)
+	}
+
+	if fake.Hits("/api/decks/") != 3 {
+		t.Errorf("Made %d search requests! Should be 3.", fake.Hits("/api/decks/"))
+	}
+
+	if query.Page != 0 {
+		t.Error("Iterating should not modify the query!")
+	}
+}
+
+func TestIterateDecksFromLaterPage(t *testing.T) {
+	fake, client := loggedInFake(t)
+
+	decks := client.IterateDecks(context.Background(), &DeckQuery{Page: 2, PageSize: 1}, 0)
+	names := []string{}
+
+	for decks.Next() {
+		names = append(names, decks.Deck().Name)
+	}
+
+	if decks.Err() != nil {
+		t.Error(decks.Err().Error())
+	}
+
+	if len(names) != fakeDeckCount-1 || names[0] != "Fake Deck 2" {
+		t.Errorf("Iterated over %v! Should be every fake deck after the first.", names)
+	}
+
+	if fake.Hits("/api/decks/") != fakeDeckCount-1 {
+		t.Errorf("Made %d search requests! Should be %d.", fake.Hits("/api/decks/"), fakeDeckCount-1)
+	}
+}
+
+func TestIterateDecksMaximum(t *testing.T) {
+	fake, client := loggedInFake(t)
+
+	decks := client.IterateDecks(context.Background(), &DeckQuery{PageSize: 2}, 3)
+	count := 0
+
+	for decks.Next() {
+		count++
+	}
+
+	if count != 3 {
+		t.Errorf("Iterated over %d decks! Should be 3.", count)
+	}
+
+	if fake.Hits("/api/decks/") != 2 {
+		t.Errorf("Made %d search requests! Should be 2.", fake.Hits("/api/decks/"))
+	}
+}
+
+func TestIterateDecksError(t *testing.T) {
+	fake := newFakeVault(t)
+	client := fake.Client(fakeUserName, fakePassword)
+
+	decks := client.IterateDecks(context.Background(), &DeckQuery{}, 0)
+
+	if decks.Next() {
+		t.Error("Iterating without logging in should not return decks!")
+	}
+
+	if !errors.Is(decks.Err(), ErrUnauthorized) {
+		t.Errorf("Iterator stopped with %v! Should be ErrUnauthorized.", decks.Err())
+	}
+}
+
+func TestIterateDecksCancelled(t *testing.T) {
+	_, client := loggedInFake(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	decks := client.IterateDecks(ctx, &DeckQuery{PageSize: 2}, 0)
+
+	if !decks.Next() {
+		t.Fatal("The first deck should have been returned!")
+	}
+
+	cancel()
+
+	if decks.Next() {
+		t.Error("Iteration should stop once the context is cancelled!")
+	}
+
+	if !errors.Is(decks.Err(), context.Canceled) {
+		t.Errorf("Iterator stopped with %v! Should be context.Canceled.", decks.Err())
+	}
+}
@@ -1,11 +1,35 @@
 package keyforgevault
 
 import (
-	keyforge "keyforge/game"
 	"testing"
 )
 
 func TestVaultLogin(t *testing.T) {
-	game := keyforge.NewGame()
-	game.Start()
+	fake := newFakeVault(t)
+	client := fake.Client(fakeUserName, fakePassword)
+
+	user, e := client.Login()
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if user.UserName != fakeUserName {
+		t.Errorf("Logged in as %s! Should be %s.", user.UserName, fakeUserName)
+	}
+
+	if user.Token != fakeToken || client.User.Token != fakeToken {
+		t.Error("The Vault token was not stored on the user!")
+	}
+}
+
+func TestVaultLoginBadPassword(t *testing.T) {
+	fake := newFakeVault(t)
+	client := fake.Client(fakeUserName, "wrong")
+
+	_, e := client.Login()
+
+	if e == nil {
+		t.Error("Logging in with the wrong password should fail!")
+	}
 }
@@ -0,0 +1,96 @@
+package keyforgevault
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestDeckQueryIsReusable(t *testing.T) {
+	query := DeckQuery{Query: "Fake", MinimumChains: 1, MaximumChains: 4}
+
+	first, e := query.GetQueryString()
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	second, _ := query.GetQueryString()
+
+	if first != second {
+		t.Errorf("Query string changed from %s to %s!", first, second)
+	}
+
+	if query.Page != 0 || query.PageSize != 0 {
+		t.Error("Building the query string should not modify the query!")
+	}
+}
+
+func TestDeckQueryFilters(t *testing.T) {
+	query := DeckQuery{
+		Page:      3,
+		PageSize:  10,
+		Houses:    []string{"Brobnar", "Dis"},
+		Expansion: 341,
+		Owner:     "tester",
+		MyDecks:   true,
+		Favorites: true,
+		WatchList: true,
+		Ordering:  "chains",
+	}
+
+	encoded, e := query.GetQueryString()
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	values, _ := url.ParseQuery(encoded)
+	expected := map[string]string{
+		"page":               "3",
+		"page_size":          "10",
+		"houses":             "Brobnar,Dis",
+		"expansion":          "341",
+		"owner":              "tester",
+		"is_my_deck":         "true",
+		"is_my_favorite":     "true",
+		"is_on_my_watchlist": "true",
+		"ordering":           "chains",
+	}
+
+	for key, value := range expected {
+		if values.Get(key) != value {
+			t.Errorf("Parameter %s is %s! Should be %s.", key, values.Get(key), value)
+		}
+	}
+
+	query.Descending = true
+	encoded, _ = query.GetQueryString()
+	values, _ = url.ParseQuery(encoded)
+
+	if values.Get("ordering") != "-chains" {
+		t.Errorf("Descending ordering is %s! Should be -chains.", values.Get("ordering"))
+	}
+}
+
+func TestDeckQueryInvalidPage(t *testing.T) {
+	query := DeckQuery{Page: -1}
+
+	if _, e := query.GetQueryString(); e == nil {
+		t.Error("A negative page should be rejected!")
+	}
+}
+
+func TestDeckQueryHousesSearch(t *testing.T) {
+	fake, client := loggedInFake(t)
+	house := fake.Decks[0].Deck.Links.Houses[0]
+
+	result, e := client.SearchDecks(&DeckQuery{PageSize: 10, Houses: []string{house}})
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if result.Count == 0 || result.Count == fakeDeckCount {
+		t.Errorf("Searching for %s found %d decks! Should be some but not all.", house, result.Count)
+	}
+}
@@ -0,0 +1,166 @@
+package keyforgevault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Default rate limit used by NewClient.
+const (
+	DefaultRequestsPerSecond = 2
+	DefaultBurst             = 5
+)
+
+// RateLimiter - A token bucket limiting how often requests are sent. The
+// bucket holds up to Burst tokens and refills at a fixed rate; each request
+// takes a token, waiting for one if the bucket is empty. A limiter is safe
+// to share between goroutines and clients.
+type RateLimiter struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter - Returns a full rate limiter allowing requestsPerSecond
+// requests on average with bursts of up to burst requests. Returns an error
+// unless the rate is positive and finite and the burst is at least one.
+func NewRateLimiter(requestsPerSecond float64, burst int) (*RateLimiter, error) {
+	if !(requestsPerSecond > 0) || math.IsInf(requestsPerSecond, 1) {
+		errorMessage := fmt.Sprintf("vault: invalid rate limit of %v requests per second", requestsPerSecond)
+		return nil, errors.New(errorMessage)
+	}
+
+	if burst < 1 {
+		errorMessage := fmt.Sprintf("vault: invalid rate limit burst of %d requests", burst)
+		return nil, errors.New(errorMessage)
+	}
+
+	limiter := &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+
+	return limiter, nil
+}
+
+// Wait - Takes a token, blocking until one is available. Returns the
+// context's error if it is done first, in which case no token is taken.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	r.mutex.Lock()
+
+	now := time.Now()
+	r.tokens += now.Sub(r.last).Seconds() * r.rate
+	r.last = now
+
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+
+	// Taking the token up front reserves it, so waiting goroutines are
+	// served in the order they arrived.
+	r.tokens--
+	wait := time.Duration(0)
+
+	if r.tokens < 0 {
+		wait = time.Duration(-r.tokens / r.rate * float64(time.Second))
+	}
+
+	r.mutex.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	e := sleep(ctx, wait)
+
+	if e != nil {
+		r.mutex.Lock()
+		r.tokens++
+		r.mutex.Unlock()
+	}
+
+	return e
+}
+
+// RetryPolicy - Describes how failed requests are retried. Requests that
+// were rate limited or failed with a 5xx status are retried up to
+// MaxRetries times, waiting BaseDelay before the first retry and doubling
+// the wait each time up to MaxDelay. Jitter adds a random extra wait of up
+// to that fraction of the delay. A Retry-After sent by the Vault is honored
+// when it asks for a longer wait. The zero policy never retries.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	Jitter     float64
+}
+
+// DefaultRetryPolicy - Retry policy used by NewClient.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 4,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   30 * time.Second,
+	Jitter:     0.2,
+}
+
+// retryDelay - Determine whether a request that failed with the given error
+// on the given attempt, counting from zero, should be retried and how long
+// to wait first.
+func (r RetryPolicy) retryDelay(attempt int, e error) (time.Duration, bool) {
+	if attempt >= r.MaxRetries || !retryable(e) {
+		return 0, false
+	}
+
+	delay := r.BaseDelay << uint(attempt)
+
+	if delay > r.MaxDelay && r.MaxDelay > 0 || delay < 0 {
+		delay = r.MaxDelay
+	}
+
+	if r.Jitter > 0 && delay > 0 {
+		delay += time.Duration(rand.Float64() * r.Jitter * float64(delay))
+	}
+
+	rateLimit := &RateLimitError{}
+
+	if errors.As(e, &rateLimit) && rateLimit.RetryAfter > delay {
+		delay = rateLimit.RetryAfter
+	}
+
+	return delay, true
+}
+
+// retryable - Determine whether an error is worth retrying: rate limiting
+// and server errors are, everything else is not.
+func retryable(e error) bool {
+	responseError := &ResponseError{}
+
+	if errors.As(e, &responseError) {
+		return responseError.StatusCode >= http.StatusInternalServerError
+	}
+
+	return errors.Is(e, ErrRateLimited)
+}
+
+// sleep - Waits for the given duration or until the context is done.
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
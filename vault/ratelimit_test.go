@@ -0,0 +1,192 @@
+package keyforgevault
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyClient - Returns a client for a server that answers the first
+// failures requests with the given status and headers, then succeeds. The
+// client records its retry delays instead of sleeping.
+func flakyClient(t *testing.T, failures int, status int, headers map[string]string) (*Client, *int32, *[]time.Duration) {
+	hits := int32(0)
+	delays := []time.Duration{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if int(atomic.AddInt32(&hits, 1)) <= failures {
+			for key, value := range headers {
+				w.Header().Set(key, value)
+			}
+
+			w.WriteHeader(status)
+			return
+		}
+
+		w.Write([]byte(`{"data": {"id": "deck-id", "name": "` + string(body) + `"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(fakeUserName, fakePassword)
+	client.VaultURL = server.URL
+	client.HTTPClient = server.Client()
+	client.RateLimiter = nil
+	client.RetryPolicy = RetryPolicy{MaxRetries: 3, BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second}
+	client.sleep = func(ctx context.Context, duration time.Duration) error {
+		delays = append(delays, duration)
+		return nil
+	}
+
+	return client, &hits, &delays
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	client, hits, delays := flakyClient(t, 2, http.StatusServiceUnavailable, nil)
+
+	deck, e := client.RetrieveDeck("deck-id")
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if deck.ID != "deck-id" || *hits != 3 {
+		t.Errorf("Made %d requests! Should have succeeded on the third.", *hits)
+	}
+
+	expected := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond}
+
+	if len(*delays) != 2 || (*delays)[0] != expected[0] || (*delays)[1] != expected[1] {
+		t.Errorf("Waited %v between retries! Should be %v.", *delays, expected)
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	client, hits, delays := flakyClient(t, 1, http.StatusTooManyRequests, map[string]string{"Retry-After": "7"})
+
+	_, e := client.RetrieveDeck("deck-id")
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if *hits != 2 || len(*delays) != 1 || (*delays)[0] != 7*time.Second {
+		t.Errorf("Waited %v before retrying! Should be 7s.", *delays)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	client, hits, _ := flakyClient(t, 10, http.StatusBadGateway, nil)
+
+	_, e := client.RetrieveDeck("deck-id")
+
+	if !errors.Is(e, ErrBadResponse) {
+		t.Errorf("Returned %v after giving up! Should be ErrBadResponse.", e)
+	}
+
+	if *hits != 4 {
+		t.Errorf("Made %d requests! Should be 4.", *hits)
+	}
+}
+
+func TestRetrySkipsClientErrors(t *testing.T) {
+	client, hits, _ := flakyClient(t, 10, http.StatusNotFound, nil)
+
+	_, e := client.RetrieveDeck("deck-id")
+
+	if !errors.Is(e, ErrNotFound) || *hits != 1 {
+		t.Errorf("Made %d requests! Not found errors should not be retried.", *hits)
+	}
+}
+
+func TestRetryResendsBody(t *testing.T) {
+	client, hits, _ := flakyClient(t, 1, http.StatusInternalServerError, nil)
+	result := RetrieveDeckJSON{}
+
+	request, e := client.newRequest(context.Background(), "POST", client.VaultURL, bytes.NewBufferString("payload"))
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	e = client.do(client.httpClient(), request, &result)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	if *hits != 2 || result.Deck.Name != "payload" {
+		t.Error("The request body was not sent again when retrying!")
+	}
+}
+
+func TestRateLimiterSharedAcrossGoroutines(t *testing.T) {
+	limiter, e := NewRateLimiter(50, 1)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	group := sync.WaitGroup{}
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+			limiter.Wait(context.Background())
+		}()
+	}
+
+	group.Wait()
+
+	if elapsed := time.Since(start); elapsed < 75*time.Millisecond {
+		t.Errorf("Five requests at 50 per second took %s! Should take at least 80ms.", elapsed)
+	}
+}
+
+func TestRateLimiterCancelled(t *testing.T) {
+	limiter, e := NewRateLimiter(0.1, 1)
+
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+
+	limiter.Wait(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if !errors.Is(limiter.Wait(ctx), context.DeadlineExceeded) {
+		t.Error("Waiting on an empty bucket should stop when the context is done!")
+	}
+}
+
+func TestRateLimiterInvalid(t *testing.T) {
+	invalid := []struct {
+		rate  float64
+		burst int
+	}{
+		{0, 1},
+		{-1, 1},
+		{math.NaN(), 1},
+		{math.Inf(1), 1},
+		{2, 0},
+	}
+
+	for _, limit := range invalid {
+		if _, e := NewRateLimiter(limit.rate, limit.burst); e == nil {
+			t.Errorf("A rate limit of %v per second with a burst of %d should be rejected!", limit.rate, limit.burst)
+		}
+	}
+}
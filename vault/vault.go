@@ -1,14 +1,13 @@
 package keyforgevault
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"io/ioutil"
 	keyforge "keyforge/game"
-	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 type Credentials struct {
@@ -94,6 +93,10 @@ type HouseJSON struct {
 	Image string `json:"image"`
 }
 
+// DeckQuery - Describes a deck search. Zero values leave a filter out, so
+// the zero query matches every deck. Level and chain ranges are only sent
+// when both ends are set and in order. Ordering names the field to sort by,
+// such as "date" or "chains"; Descending reverses it.
 type DeckQuery struct {
 	Page          int
 	PageSize      int
@@ -102,205 +105,134 @@ type DeckQuery struct {
 	MaximumLevel  int
 	MinimumChains int
 	MaximumChains int
+	Houses        []string
+	Expansion     int
+	Owner         string
+	MyDecks       bool
+	Favorites     bool
+	WatchList     bool
 	Ordering      string
-	Values        url.Values
+	Descending    bool
 }
 
+// GetQueryString - Returns the URL query string for a deck search. The
+// query itself is left untouched, so it can be reused between requests.
+// Page and page size default to 1 when not set.
 func (d *DeckQuery) GetQueryString() (string, error) {
-	if d.Values == nil {
-		d.Values = url.Values{}
+	if d.Page < 0 || d.PageSize < 0 {
+		errorMessage := fmt.Sprintf("invalid page %d with page size %d", d.Page, d.PageSize)
+		return "", errors.New(errorMessage)
 	}
 
-	if d.Page == 0 {
-		d.Page = 1
-	}
+	values := url.Values{}
+	page := d.Page
+	pageSize := d.PageSize
 
-	if d.PageSize == 0 {
-		d.PageSize = 1
+	if page == 0 {
+		page = 1
 	}
 
-	page := strconv.Itoa(d.Page)
-	pageSize := strconv.Itoa(d.PageSize)
+	if pageSize == 0 {
+		pageSize = 1
+	}
 
-	d.Values.Add("page", page)
-	d.Values.Add("page_size", pageSize)
-	d.Values.Add("search", d.Query)
+	values.Set("page", strconv.Itoa(page))
+	values.Set("page_size", strconv.Itoa(pageSize))
+	values.Set("search", d.Query)
 
 	if d.MinimumChains > 0 && d.MaximumChains > 0 && d.MaximumChains >= d.MinimumChains {
 		chainString := fmt.Sprintf("%d,%d", d.MinimumChains, d.MaximumChains)
-		d.Values.Add("chains", chainString)
+		values.Set("chains", chainString)
 	}
 
 	if d.MinimumLevel > 0 && d.MaximumLevel > 0 && d.MaximumLevel >= d.MinimumLevel {
 		levelString := fmt.Sprintf("%d,%d", d.MinimumLevel, d.MaximumLevel)
-		d.Values.Add("power_level", levelString)
+		values.Set("power_level", levelString)
 	}
 
-	if len(d.Ordering) > 0 {
-		d.Values.Add("ordering", d.Ordering)
+	if len(d.Houses) > 0 {
+		values.Set("houses", strings.Join(d.Houses, ","))
 	}
 
-	return d.Values.Encode(), nil
-}
-
-func Login(userName, password string) (VaultUser, error) {
-	credentials := Credentials{}
-
-	client := &http.Client{
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			return http.ErrUseLastResponse
-		},
+	if d.Expansion > 0 {
+		values.Set("expansion", strconv.Itoa(d.Expansion))
 	}
 
-	params := url.Values{}
-	params.Add("display", "popup")
-	params.Add("scope", "openid profile email")
-	params.Add("response_type", "id_token token")
-	params.Add("client_id", "keyforge-web-portal")
-	params.Add("state", "/")
-	params.Add("redirect_uri", "https://www.keyforgegame.com/authorize")
-	params.Add("nonce", "4HM~Z5f8")
-
-	loginForm := params
-	loginForm.Add("login", userName)
-	loginForm.Add("password", password)
-
-	path := fmt.Sprintf("https://account.asmodee.net/en/signin?%s", params.Encode())
-
-	response, e := client.PostForm(path, loginForm)
-
-	if e != nil {
-		return VaultUser{}, e
+	if len(d.Owner) > 0 {
+		values.Set("owner", d.Owner)
 	}
 
-	redirectLocation, e := response.Location()
-
-	if e != nil {
-		return VaultUser{}, e
+	if d.MyDecks {
+		values.Set("is_my_deck", "true")
 	}
 
-	redirectLocation.RawQuery = redirectLocation.Fragment
-	redirectParams := redirectLocation.Query()
-
-	credentials.AccessToken = redirectParams.Get("access_token")
-	credentials.IDToken = redirectParams.Get("id_token")
-	credentials.Nonce = "4HM~Z5f8"
-
-	jsonObject, e := json.Marshal(&credentials)
+	if d.Favorites {
+		values.Set("is_my_favorite", "true")
+	}
 
-	if e != nil {
-		return VaultUser{}, e
+	if d.WatchList {
+		values.Set("is_on_my_watchlist", "true")
 	}
 
-	path = fmt.Sprintf("https://www.keyforgegame.com/api/users/login/asmodee/")
+	if len(d.Ordering) > 0 {
+		ordering := strings.TrimPrefix(d.Ordering, "-")
 
-	contentLength := strconv.Itoa(len(jsonObject))
-	request, e := http.NewRequest("POST", path, bytes.NewBuffer(jsonObject))
-	request.Header.Set("Content-Type", "application/json")
-	request.Header.Set("Content-Length", contentLength)
+		if d.Descending {
+			ordering = "-" + ordering
+		}
 
-	if e != nil {
-		return VaultUser{}, e
+		values.Set("ordering", ordering)
 	}
 
-	response, e = client.Do(request)
+	return values.Encode(), nil
+}
 
-	if e != nil {
-		return VaultUser{}, e
-	}
+// defaultRateLimiter - Rate limiter shared by every default client, so
+// callers of the package-level functions stay within the default rate limit
+// together, however many goroutines they use. The default rate limit is
+// always valid.
+var defaultRateLimiter, _ = NewRateLimiter(DefaultRequestsPerSecond, DefaultBurst)
 
-	body, e := ioutil.ReadAll(response.Body)
-	defer response.Body.Close()
+// defaultClient - Returns the client used by the package-level functions.
+func defaultClient(userName, password string) *Client {
+	client := NewClient(userName, password)
+	client.RateLimiter = defaultRateLimiter
 
-	userResult := SearchVaultUserJSON{}
-	json.Unmarshal(body, &userResult)
+	return client
+}
 
-	userResult.Data.User.Token = userResult.Data.Token
+// Login - Logs into the Vault using the default client.
+func Login(userName, password string) (VaultUser, error) {
+	return LoginContext(context.Background(), userName, password)
+}
 
-	return userResult.Data.User, nil
+// LoginContext - Login, giving up once ctx is cancelled.
+func LoginContext(ctx context.Context, userName, password string) (VaultUser, error) {
+	return defaultClient(userName, password).LoginContext(ctx)
 }
 
+// SearchDecks - Searches the Vault for decks using the default client.
 func SearchDecks(vaultUser *VaultUser, deckQuery *DeckQuery) (PartialDeckSearchJSON, error) {
-	client := &http.Client{}
-	authHeader := fmt.Sprintf("Token %s", vaultUser.Token)
-	params, e := deckQuery.GetQueryString()
-
-	if e != nil {
-		return PartialDeckSearchJSON{}, e
-	}
-
-	path := fmt.Sprintf("https://www.keyforgegame.com/api/decks/?%s", params)
-
-	request, e := http.NewRequest("GET", path, nil)
-	request.Header.Add("Authorization", authHeader)
-
-	if e != nil {
-		return PartialDeckSearchJSON{}, e
-	}
-
-	response, e := client.Do(request)
-
-	body, e := ioutil.ReadAll(response.Body)
-	defer response.Body.Close()
-
-	result := PartialDeckSearchJSON{}
-	e = json.Unmarshal(body, &result)
+	return SearchDecksContext(context.Background(), vaultUser, deckQuery)
+}
 
-	if e != nil {
-		return PartialDeckSearchJSON{}, e
-	}
+// SearchDecksContext - SearchDecks, giving up once ctx is cancelled.
+func SearchDecksContext(ctx context.Context, vaultUser *VaultUser, deckQuery *DeckQuery) (PartialDeckSearchJSON, error) {
+	client := defaultClient("", "")
+	client.User = *vaultUser
 
-	return result, nil
+	return client.SearchDecksContext(ctx, deckQuery)
 }
 
+// RetrieveDeck - Retrieves a deck from the Vault using the default client.
 func RetrieveDeck(vaultUser *VaultUser, deckID string) (keyforge.Deck, error) {
-	newDeck := keyforge.Deck{}
-	client := &http.Client{}
-	deckJSON := RetrieveDeckJSON{}
-	authHeader := fmt.Sprintf("Token %s", vaultUser.Token)
-	path := fmt.Sprintf("https://www.keyforgegame.com/api/decks/%s/?links=cards,notes", deckID)
-
-	request, e := http.NewRequest("GET", path, nil)
-	request.Header.Add("Authorization", authHeader)
-
-	if e != nil {
-		return keyforge.Deck{}, e
-	}
-
-	response, e := client.Do(request)
-
-	body, e := ioutil.ReadAll(response.Body)
-	defer response.Body.Close()
-
-	e = json.Unmarshal(body, &deckJSON)
-
-	if e != nil {
-		return keyforge.Deck{}, e
-	}
+	return RetrieveDeckContext(context.Background(), vaultUser, deckID)
+}
 
-	newDeck.CasualLosses = deckJSON.Deck.CasualLosses
-	newDeck.CasualWins = deckJSON.Deck.CasualWins
-	newDeck.Chains = deckJSON.Deck.Chains
-	newDeck.Expansion = deckJSON.Deck.Expansion
-	//newDeck.Houses = deckJSON.Deck.Houses
-	newDeck.ID = deckJSON.Deck.ID
-	newDeck.IsMyDeck = deckJSON.Deck.IsMyDeck
-	newDeck.IsMyFavorite = deckJSON.Deck.IsMyFavorite
-	newDeck.IsOnWatchList = deckJSON.Deck.IsOnWatchList
-	newDeck.Losses = deckJSON.Deck.Losses
-	newDeck.Name = deckJSON.Deck.Name
-	newDeck.Notes = deckJSON.Deck.Notes
-	newDeck.Wins = deckJSON.Deck.Wins
-	newDeck.CardList = deckJSON.Deck.Links.CardList
-
-	for _, cardID := range newDeck.CardList {
-		for _, card := range deckJSON.Linked.Cards {
-			if card.ID == cardID {
-				newDeck.Cards = append(newDeck.Cards, card)
-				break
-			}
-		}
-	}
+// RetrieveDeckContext - RetrieveDeck, giving up once ctx is cancelled.
+func RetrieveDeckContext(ctx context.Context, vaultUser *VaultUser, deckID string) (keyforge.Deck, error) {
+	client := defaultClient("", "")
+	client.User = *vaultUser
 
-	return newDeck, nil
+	return client.RetrieveDeckContext(ctx, deckID)
 }